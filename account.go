@@ -0,0 +1,45 @@
+package pacifica
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type accountInfoResponse struct {
+	Success bool        `json:"success"`
+	Data    AccountInfo `json:"data"`
+	Error   interface{} `json:"error"`
+	Code    interface{} `json:"code"`
+}
+
+// AccountInfo represents the balance, equity and margin summary of an account
+type AccountInfo struct {
+	Balance             string `json:"balance"`
+	FeeLevel            int    `json:"fee_level"`
+	AccountEquity       string `json:"account_equity"`
+	AvailableToSpend    string `json:"available_to_spend"`
+	AvailableToWithdraw string `json:"available_to_withdraw"`
+	PendingBalance      string `json:"pending_balance"`
+	TotalMarginUsed     string `json:"total_margin_used"`
+	CrossMMR            string `json:"cross_mmr"`
+	PositionsCount      int    `json:"positions_count"`
+	OrdersCount         int    `json:"orders_count"`
+	StopOrdersCount     int    `json:"stop_orders_count"`
+	UpdatedAt           int64  `json:"updated_at"`
+}
+
+func (c *RESTClient) GetAccountInfo(ctx context.Context, account string) (*AccountInfo, error) {
+	if account == "" {
+		return nil, fmt.Errorf("account info: account is required")
+	}
+
+	query := url.Values{}
+	query.Set("account", account)
+
+	accountInfoResp, err := doGet[accountInfoResponse](ctx, c, "/account", query)
+	if err != nil {
+		return nil, fmt.Errorf("account info: %w", err)
+	}
+	return &accountInfoResp.Data, nil
+}
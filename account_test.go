@@ -0,0 +1,45 @@
+package pacifica
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRESTClient_GetAccountInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/account", r.URL.Path)
+		assert.Equal(t, testAccountID, r.URL.Query().Get("account"))
+		_, _ = w.Write([]byte(`{"success":true,"data":{"balance":"2000.000000","fee_level":1,` +
+			`"account_equity":"2150.250000","available_to_spend":"1800.750000","available_to_withdraw":"1500.850000",` +
+			`"pending_balance":"0.000000","total_margin_used":"349.500000","cross_mmr":"420.690000",` +
+			`"positions_count":2,"orders_count":3,"stop_orders_count":1,"updated_at":1716200000000},"error":null,"code":null}`))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, nil)
+
+	info, err := client.GetAccountInfo(context.Background(), testAccountID)
+	require.NoError(t, err)
+	assert.Equal(t, &AccountInfo{
+		Balance:             "2000.000000",
+		FeeLevel:            1,
+		AccountEquity:       "2150.250000",
+		AvailableToSpend:    "1800.750000",
+		AvailableToWithdraw: "1500.850000",
+		PendingBalance:      "0.000000",
+		TotalMarginUsed:     "349.500000",
+		CrossMMR:            "420.690000",
+		PositionsCount:      2,
+		OrdersCount:         3,
+		StopOrdersCount:     1,
+		UpdatedAt:           1716200000000,
+	}, info)
+
+	_, err = client.GetAccountInfo(context.Background(), "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,134 @@
+package pacifica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// AgentWalletRequest represents the request data for binding or revoking an agent wallet
+type AgentWalletRequest struct {
+	AgentWallet string `json:"agent_wallet"`
+}
+
+func (r AgentWalletRequest) String() string {
+	data, _ := json.Marshal(r)
+	return string(data)
+}
+
+// AgentWalletOptions contains optional parameters for binding or revoking an agent wallet
+type AgentWalletOptions struct {
+	ExpiryWindow int64
+}
+
+// buildAgentWalletRequest builds a request for an agent wallet operation signed by the account owner key
+func (s *Exchange) buildAgentWalletRequest(ctx context.Context, operationType string, params AgentWalletRequest, opts *AgentWalletOptions) (map[string]interface{}, error) {
+	// Validate required fields
+	if params.AgentWallet == "" {
+		return nil, fmt.Errorf("agent_wallet is required")
+	}
+	if s.IsAgent() {
+		return nil, fmt.Errorf("agent wallets must be managed with the account owner key")
+	}
+	if params.AgentWallet == s.accountID {
+		return nil, fmt.Errorf("agent_wallet must differ from the account")
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal params: %w", err)
+	}
+
+	var operationData map[string]interface{}
+	if err := json.Unmarshal(data, &operationData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal params: %w", err)
+	}
+
+	// Determine expiry window
+	expiryWindow := int64(0)
+	if opts != nil && opts.ExpiryWindow != 0 {
+		expiryWindow = opts.ExpiryWindow
+	}
+
+	// The agent_wallet of the operation data replaces the signer key in the request
+	request, err := s.BuildSignedRequestWithContext(ctx, operationType, operationData, expiryWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build signed request: %w", err)
+	}
+
+	return request, nil
+}
+
+// BuildBindAgentWalletRequest builds a request authorising an agent key to sign on behalf of the account
+func (s *Exchange) BuildBindAgentWalletRequest(params AgentWalletRequest, opts *AgentWalletOptions) (map[string]interface{}, error) {
+	return buildBindAgentWalletRequest(context.Background(), s, params, opts)
+}
+
+// buildBindAgentWalletRequest is BuildBindAgentWalletRequest passing ctx to the signer
+func buildBindAgentWalletRequest(ctx context.Context, s *Exchange, params AgentWalletRequest, opts *AgentWalletOptions) (map[string]interface{}, error) {
+	return s.buildAgentWalletRequest(ctx, "bind_agent_wallet", params, opts)
+}
+
+// BuildRevokeAgentWalletRequest builds a request revoking a previously bound agent key
+func (s *Exchange) BuildRevokeAgentWalletRequest(params AgentWalletRequest, opts *AgentWalletOptions) (map[string]interface{}, error) {
+	return buildRevokeAgentWalletRequest(context.Background(), s, params, opts)
+}
+
+// buildRevokeAgentWalletRequest is BuildRevokeAgentWalletRequest passing ctx to the signer
+func buildRevokeAgentWalletRequest(ctx context.Context, s *Exchange, params AgentWalletRequest, opts *AgentWalletOptions) (map[string]interface{}, error) {
+	return s.buildAgentWalletRequest(ctx, "revoke_agent_wallet", params, opts)
+}
+
+// AgentWalletResponse represents the response from the agent wallet endpoints
+type AgentWalletResponse struct {
+	Success bool `json:"success"`
+}
+
+// BindAgentWallet authorises an agent key to sign on behalf of the client account on Pacifica
+func (c *RESTClient) BindAgentWallet(params AgentWalletRequest, opts *AgentWalletOptions) (*AgentWalletResponse, error) {
+	return c.BindAgentWalletWithContext(context.Background(), params, opts)
+}
+
+// BindAgentWalletWithContext is like BindAgentWallet but aborts the HTTP request when ctx is done
+func (c *RESTClient) BindAgentWalletWithContext(ctx context.Context, params AgentWalletRequest, opts *AgentWalletOptions) (*AgentWalletResponse, error) {
+	return doSigned[AgentWalletRequest, AgentWalletResponse](ctx, c, "/agent/bind", buildBindAgentWalletRequest, params, opts)
+}
+
+// RevokeAgentWallet revokes an agent key of the client account on Pacifica
+func (c *RESTClient) RevokeAgentWallet(params AgentWalletRequest, opts *AgentWalletOptions) (*AgentWalletResponse, error) {
+	return c.RevokeAgentWalletWithContext(context.Background(), params, opts)
+}
+
+// RevokeAgentWalletWithContext is like RevokeAgentWallet but aborts the HTTP request when ctx is done
+func (c *RESTClient) RevokeAgentWalletWithContext(ctx context.Context, params AgentWalletRequest, opts *AgentWalletOptions) (*AgentWalletResponse, error) {
+	return doSigned[AgentWalletRequest, AgentWalletResponse](ctx, c, "/agent/revoke", buildRevokeAgentWalletRequest, params, opts)
+}
+
+// AgentWallet represents an agent key bound to an account
+type AgentWallet struct {
+	AgentWallet string `json:"agent_wallet"`
+	CreatedAt   int64  `json:"created_at"`
+}
+
+type agentWalletsResponse struct {
+	Success bool          `json:"success"`
+	Data    []AgentWallet `json:"data"`
+	Error   interface{}   `json:"error"`
+	Code    interface{}   `json:"code"`
+}
+
+func (c *RESTClient) ListAgentWallets(ctx context.Context, account string) ([]AgentWallet, error) {
+	if account == "" {
+		return nil, fmt.Errorf("agent wallets: account is required")
+	}
+
+	query := url.Values{}
+	query.Set("account", account)
+
+	agentWalletsResp, err := doGet[agentWalletsResponse](ctx, c, "/agent/list", query)
+	if err != nil {
+		return nil, fmt.Errorf("agent wallets: %w", err)
+	}
+	return agentWalletsResp.Data, nil
+}
@@ -0,0 +1,108 @@
+package pacifica
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildBindAgentWalletRequest(t *testing.T) {
+	owner := generateTestOwnerExchange(t)
+	agent := generateTestExchange(t)
+
+	params := AgentWalletRequest{AgentWallet: agent.GetPublicKey()}
+	req, err := owner.BuildBindAgentWalletRequest(params, &AgentWalletOptions{ExpiryWindow: 10000})
+	require.NoError(t, err)
+
+	assert.Equal(t, owner.GetPublicKey(), req["account"])
+	assert.Equal(t, agent.GetPublicKey(), req["agent_wallet"])
+	assert.Equal(t, int64(10000), req["expiry_window"])
+
+	// The owner key signs the agent key
+	message, err := createCompactJSON(map[string]interface{}{
+		"timestamp":     req["timestamp"],
+		"expiry_window": req["expiry_window"],
+		"type":          "bind_agent_wallet",
+		"data":          map[string]interface{}{"agent_wallet": agent.GetPublicKey()},
+	})
+	require.NoError(t, err)
+	assert.True(t, owner.VerifySignature(message, req["signature"].(string)))
+}
+
+func TestBuildAgentWalletRequestValidation(t *testing.T) {
+	owner := generateTestOwnerExchange(t)
+	agent := generateTestExchange(t)
+
+	tests := []struct {
+		name   string
+		signer *Exchange
+		params AgentWalletRequest
+	}{
+		{
+			name:   "missing agent_wallet",
+			signer: owner,
+		},
+		{
+			name:   "signed by agent key",
+			signer: agent,
+			params: AgentWalletRequest{AgentWallet: owner.GetPublicKey()},
+		},
+		{
+			name:   "agent_wallet is the account",
+			signer: owner,
+			params: AgentWalletRequest{AgentWallet: owner.GetPublicKey()},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.signer.BuildBindAgentWalletRequest(tt.params, nil)
+			assert.Error(t, err)
+			_, err = tt.signer.BuildRevokeAgentWalletRequest(tt.params, nil)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRESTClientAgentWallets(t *testing.T) {
+	owner := generateTestOwnerExchange(t)
+	agent := generateTestExchange(t)
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/agent/bind", "/agent/revoke":
+			_, _ = w.Write([]byte(`{"success":true}`))
+		case "/agent/list":
+			assert.Equal(t, owner.GetPublicKey(), r.URL.Query().Get("account"))
+			_, _ = w.Write([]byte(`{"success":true,"data":[{"agent_wallet":"` + agent.GetPublicKey() +
+				`","created_at":1759222804122}],"error":null,"code":null}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, owner)
+	params := AgentWalletRequest{AgentWallet: agent.GetPublicKey()}
+
+	response, err := client.BindAgentWallet(params, nil)
+	require.NoError(t, err)
+	assert.True(t, response.Success)
+
+	wallets, err := client.ListAgentWallets(context.Background(), owner.GetPublicKey())
+	require.NoError(t, err)
+	require.Len(t, wallets, 1)
+	assert.Equal(t, agent.GetPublicKey(), wallets[0].AgentWallet)
+
+	response, err = client.RevokeAgentWallet(params, nil)
+	require.NoError(t, err)
+	assert.True(t, response.Success)
+
+	assert.Equal(t, []string{"/agent/bind", "/agent/list", "/agent/revoke"}, paths)
+}
@@ -0,0 +1,208 @@
+package pacifica
+
+import (
+	"context"
+	"fmt"
+)
+
+// BatchActionType represents the type of a single action in a batch
+type BatchActionType string
+
+const (
+	BatchActionCreate       BatchActionType = "Create"
+	BatchActionCreateMarket BatchActionType = "CreateMarket"
+	BatchActionCancel       BatchActionType = "Cancel"
+)
+
+// BatchOrderAction represents a single action in a batch, exactly one field must be set
+type BatchOrderAction struct {
+	CreateLimit  *CreateLimitOrderRequest
+	CreateMarket *CreateMarketOrderRequest
+	Cancel       *CancelOrderRequest
+}
+
+// Type returns the batch action type, or an empty string if the action is not valid
+func (a BatchOrderAction) Type() BatchActionType {
+	var (
+		actionType BatchActionType
+		set        int
+	)
+	if a.CreateLimit != nil {
+		actionType = BatchActionCreate
+		set++
+	}
+	if a.CreateMarket != nil {
+		actionType = BatchActionCreateMarket
+		set++
+	}
+	if a.Cancel != nil {
+		actionType = BatchActionCancel
+		set++
+	}
+	if set != 1 {
+		return ""
+	}
+	return actionType
+}
+
+// BatchOrdersOptions contains optional parameters applied to every action of a batch
+type BatchOrdersOptions struct {
+	AgentWallet  *string
+	ExpiryWindow int64
+}
+
+// buildBatchAction signs a single batch action
+func (s *Exchange) buildBatchAction(ctx context.Context, action BatchOrderAction, opts *BatchOrdersOptions) (map[string]interface{}, error) {
+	var (
+		agentWallet  *string
+		expiryWindow int64
+	)
+	if opts != nil {
+		agentWallet = opts.AgentWallet
+		expiryWindow = opts.ExpiryWindow
+	}
+
+	var (
+		data map[string]interface{}
+		err  error
+	)
+	actionType := action.Type()
+	switch actionType {
+	case BatchActionCreate:
+		data, err = buildCreateLimitOrderRequest(ctx, s, *action.CreateLimit, &CreateLimitOrderOptions{
+			AgentWallet:  agentWallet,
+			ExpiryWindow: expiryWindow,
+		})
+	case BatchActionCreateMarket:
+		data, err = buildCreateMarketOrderRequest(ctx, s, *action.CreateMarket, &CreateMarketOrderOptions{
+			AgentWallet:  agentWallet,
+			ExpiryWindow: expiryWindow,
+		})
+	case BatchActionCancel:
+		data, err = buildCancelOrderRequest(ctx, s, *action.Cancel, &CancelOrderOptions{
+			AgentWallet:  agentWallet,
+			ExpiryWindow: expiryWindow,
+		})
+	default:
+		return nil, fmt.Errorf("exactly one of create_limit, create_market or cancel must be set")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"type": string(actionType),
+		"data": data,
+	}, nil
+}
+
+// BuildBatchOrdersRequest builds a batch request where every action is signed individually
+func (s *Exchange) BuildBatchOrdersRequest(actions []BatchOrderAction, opts *BatchOrdersOptions) (map[string]interface{}, error) {
+	return buildBatchOrdersRequest(context.Background(), s, actions, opts)
+}
+
+// buildBatchOrdersRequest is BuildBatchOrdersRequest passing ctx to the signer
+func buildBatchOrdersRequest(ctx context.Context, s *Exchange, actions []BatchOrderAction, opts *BatchOrdersOptions) (map[string]interface{}, error) {
+	if len(actions) == 0 {
+		return nil, fmt.Errorf("at least one action is required")
+	}
+
+	signedActions := make([]interface{}, 0, len(actions))
+	for i, action := range actions {
+		signedAction, err := s.buildBatchAction(ctx, action, opts)
+		if err != nil {
+			return nil, fmt.Errorf("action %d: %w", i, err)
+		}
+		signedActions = append(signedActions, signedAction)
+	}
+
+	return map[string]interface{}{
+		"actions": signedActions,
+	}, nil
+}
+
+// BatchActionError represents a failure of a single action inside a batch
+type BatchActionError struct {
+	Index int
+	Type  BatchActionType
+	// Code is the exchange error code of the action, CodeUnknown when the result has none
+	Code    int
+	Message string
+}
+
+func (e *BatchActionError) Error() string {
+	return fmt.Sprintf("batch action %d (%s): %s", e.Index, e.Type, e.Message)
+}
+
+// Is reports whether the error matches one of the sentinel errors
+func (e *BatchActionError) Is(target error) bool {
+	return matchesSentinel(e.Code, e.Message, target)
+}
+
+// BatchOrderResult represents the outcome of a single action inside a batch
+type BatchOrderResult struct {
+	Success bool
+	OrderID int64
+	Err     error
+}
+
+// BatchOrdersResponse represents the response from the batch orders endpoint
+type BatchOrdersResponse struct {
+	Results []BatchOrderResult
+}
+
+type batchOrderResultPayload struct {
+	Success bool        `json:"success"`
+	OrderID int64       `json:"order_id"`
+	Error   *string     `json:"error"`
+	Code    interface{} `json:"code"`
+}
+
+type batchOrdersResponsePayload struct {
+	Success bool `json:"success"`
+	Data    struct {
+		Results []batchOrderResultPayload `json:"results"`
+	} `json:"data"`
+	Error interface{} `json:"error"`
+	Code  interface{} `json:"code"`
+}
+
+// BatchOrders submits several create and cancel actions to Pacifica in a single request
+func (c *RESTClient) BatchOrders(actions []BatchOrderAction, opts *BatchOrdersOptions) (*BatchOrdersResponse, error) {
+	return c.BatchOrdersWithContext(context.Background(), actions, opts)
+}
+
+// BatchOrdersWithContext is like BatchOrders but aborts the HTTP request when ctx is done
+func (c *RESTClient) BatchOrdersWithContext(ctx context.Context, actions []BatchOrderAction, opts *BatchOrdersOptions) (*BatchOrdersResponse, error) {
+	payload, err := doSigned[[]BatchOrderAction, batchOrdersResponsePayload](ctx, c, "/orders/batch", buildBatchOrdersRequest, actions, opts)
+	if err != nil {
+		return nil, err
+	}
+	return newBatchOrdersResponse(actions, payload.Data.Results), nil
+}
+
+// newBatchOrdersResponse pairs the raw per-action results with the submitted actions
+func newBatchOrdersResponse(actions []BatchOrderAction, results []batchOrderResultPayload) *BatchOrdersResponse {
+	response := &BatchOrdersResponse{
+		Results: make([]BatchOrderResult, len(results)),
+	}
+	for i, result := range results {
+		response.Results[i] = BatchOrderResult{
+			Success: result.Success,
+			OrderID: result.OrderID,
+		}
+		if result.Success {
+			continue
+		}
+
+		actionErr := &BatchActionError{Index: i, Code: envelopeCode(result.Code), Message: "unknown error"}
+		if i < len(actions) {
+			actionErr.Type = actions[i].Type()
+		}
+		if result.Error != nil {
+			actionErr.Message = *result.Error
+		}
+		response.Results[i].Err = actionErr
+	}
+	return response
+}
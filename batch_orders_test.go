@@ -0,0 +1,155 @@
+package pacifica
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildBatchOrdersRequest(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	tests := []struct {
+		name     string
+		actions  []BatchOrderAction
+		opts     *BatchOrdersOptions
+		wantErr  bool
+		validate func(*testing.T, map[string]interface{})
+	}{
+		{
+			name: "mixed actions",
+			actions: []BatchOrderAction{
+				{CreateLimit: &CreateLimitOrderRequest{Symbol: "BTC", Price: "50000", Amount: "0.1", Side: SideBid, TIF: TIFGTC}},
+				{CreateMarket: &CreateMarketOrderRequest{Symbol: "ETH", Amount: "1", Side: SideAsk, SlippagePercent: "0.5"}},
+				{Cancel: &CancelOrderRequest{Symbol: "BTC", OrderID: intPtr(123)}},
+			},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				actions, ok := req["actions"].([]interface{})
+				require.True(t, ok)
+				require.Len(t, actions, 3)
+
+				expected := []struct {
+					actionType string
+					symbol     string
+				}{
+					{"Create", "BTC"},
+					{"CreateMarket", "ETH"},
+					{"Cancel", "BTC"},
+				}
+				for i, e := range expected {
+					action, ok := actions[i].(map[string]interface{})
+					require.True(t, ok)
+					assert.Equal(t, e.actionType, action["type"])
+					data, ok := action["data"].(map[string]interface{})
+					require.True(t, ok)
+					assert.Equal(t, e.symbol, data["symbol"])
+					assert.Contains(t, data, "account")
+					assert.Contains(t, data, "signature")
+					assert.Contains(t, data, "timestamp")
+				}
+			},
+		},
+		{
+			name: "options applied to every action",
+			actions: []BatchOrderAction{
+				{Cancel: &CancelOrderRequest{Symbol: "BTC", OrderID: intPtr(1)}},
+				{Cancel: &CancelOrderRequest{Symbol: "BTC", OrderID: intPtr(2)}},
+			},
+			opts: &BatchOrdersOptions{
+				AgentWallet:  stringPtr("69trU9A5..."),
+				ExpiryWindow: 10000,
+			},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				for _, a := range req["actions"].([]interface{}) {
+					data := a.(map[string]interface{})["data"].(map[string]interface{})
+					assert.Equal(t, "69trU9A5...", data["agent_wallet"])
+					assert.Equal(t, int64(10000), data["expiry_window"])
+				}
+			},
+		},
+		{
+			name:    "no actions",
+			wantErr: true,
+		},
+		{
+			name:    "empty action",
+			actions: []BatchOrderAction{{}},
+			wantErr: true,
+		},
+		{
+			name: "action with several operations",
+			actions: []BatchOrderAction{
+				{
+					CreateLimit: &CreateLimitOrderRequest{Symbol: "BTC", Price: "50000", Amount: "0.1", Side: SideBid, TIF: TIFGTC},
+					Cancel:      &CancelOrderRequest{Symbol: "BTC", OrderID: intPtr(123)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid nested action",
+			actions: []BatchOrderAction{
+				{Cancel: &CancelOrderRequest{Symbol: "BTC"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := signer.BuildBatchOrdersRequest(tt.actions, tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.validate != nil {
+				tt.validate(t, req)
+			}
+		})
+	}
+}
+
+func TestRESTClientBatchOrders(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/orders/batch", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Len(t, body["actions"], 2)
+
+		_, _ = w.Write([]byte(`{"success":true,"data":{"results":[` +
+			`{"success":true,"order_id":470506,"error":null},` +
+			`{"success":false,"order_id":null,"error":"Order not found","code":5}]},"error":null,"code":null}`))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, signer)
+	response, err := client.BatchOrders([]BatchOrderAction{
+		{CreateLimit: &CreateLimitOrderRequest{Symbol: "BTC", Price: "50000", Amount: "0.1", Side: SideBid, TIF: TIFGTC}},
+		{Cancel: &CancelOrderRequest{Symbol: "BTC", OrderID: intPtr(123)}},
+	}, nil)
+	require.NoError(t, err)
+	require.Len(t, response.Results, 2)
+
+	assert.True(t, response.Results[0].Success)
+	assert.Equal(t, int64(470506), response.Results[0].OrderID)
+	assert.NoError(t, response.Results[0].Err)
+
+	assert.False(t, response.Results[1].Success)
+	var actionErr *BatchActionError
+	require.True(t, errors.As(response.Results[1].Err, &actionErr))
+	assert.Equal(t, 1, actionErr.Index)
+	assert.Equal(t, BatchActionCancel, actionErr.Type)
+	assert.Equal(t, CodeOrderNotFound, actionErr.Code)
+	assert.Equal(t, "Order not found", actionErr.Message)
+	assert.ErrorIs(t, response.Results[1].Err, ErrOrderNotFound)
+	assert.NotErrorIs(t, response.Results[1].Err, ErrInsufficientMargin)
+}
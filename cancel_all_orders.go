@@ -0,0 +1,85 @@
+package pacifica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// CancelAllOrdersRequest represents the request data for canceling all orders
+type CancelAllOrdersRequest struct {
+	AllSymbols        bool   `json:"all_symbols"`
+	ExcludeReduceOnly bool   `json:"exclude_reduce_only"`
+	Symbol            string `json:"symbol,omitempty"`
+}
+
+func (r CancelAllOrdersRequest) String() string {
+	data, _ := json.Marshal(r)
+	return string(data)
+}
+
+// CancelAllOrdersOptions contains optional parameters for canceling all orders
+type CancelAllOrdersOptions struct {
+	AgentWallet  *string
+	ExpiryWindow int64
+}
+
+// BuildCancelAllOrdersRequest builds a signed request for canceling all orders
+func (s *Exchange) BuildCancelAllOrdersRequest(params CancelAllOrdersRequest, opts *CancelAllOrdersOptions) (map[string]interface{}, error) {
+	return buildCancelAllOrdersRequest(context.Background(), s, params, opts)
+}
+
+// buildCancelAllOrdersRequest is BuildCancelAllOrdersRequest passing ctx to the signer
+func buildCancelAllOrdersRequest(ctx context.Context, s *Exchange, params CancelAllOrdersRequest, opts *CancelAllOrdersOptions) (map[string]interface{}, error) {
+	// Either all_symbols or a single symbol must be provided
+	if params.AllSymbols && params.Symbol != "" {
+		return nil, fmt.Errorf("symbol must be empty when all_symbols is set")
+	}
+	if !params.AllSymbols && params.Symbol == "" {
+		return nil, fmt.Errorf("either all_symbols or symbol is required")
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal params: %w", err)
+	}
+
+	var operationData map[string]interface{}
+	if err := json.Unmarshal(data, &operationData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal params: %w", err)
+	}
+
+	// Determine expiry window
+	expiryWindow := int64(0)
+	if opts != nil && opts.ExpiryWindow != 0 {
+		expiryWindow = opts.ExpiryWindow
+	}
+
+	// Build signed request with operation type "cancel_all_orders"
+	request, err := s.BuildSignedRequestWithContext(ctx, "cancel_all_orders", operationData, expiryWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build signed request: %w", err)
+	}
+
+	// Add agent_wallet if provided
+	if opts != nil && opts.AgentWallet != nil {
+		request["agent_wallet"] = *opts.AgentWallet
+	}
+
+	return request, nil
+}
+
+// CancelAllOrdersResponse represents the response from the cancel all orders endpoint
+type CancelAllOrdersResponse struct {
+	CancelledCount int `json:"cancelled_count"`
+}
+
+// CancelAllOrders cancels all open orders on Pacifica, optionally scoped to one symbol
+func (c *RESTClient) CancelAllOrders(params CancelAllOrdersRequest, opts *CancelAllOrdersOptions) (*CancelAllOrdersResponse, error) {
+	return c.CancelAllOrdersWithContext(context.Background(), params, opts)
+}
+
+// CancelAllOrdersWithContext is like CancelAllOrders but aborts the HTTP request when ctx is done
+func (c *RESTClient) CancelAllOrdersWithContext(ctx context.Context, params CancelAllOrdersRequest, opts *CancelAllOrdersOptions) (*CancelAllOrdersResponse, error) {
+	return doSigned[CancelAllOrdersRequest, CancelAllOrdersResponse](ctx, c, "/orders/cancel_all", buildCancelAllOrdersRequest, params, opts)
+}
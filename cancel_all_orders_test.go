@@ -0,0 +1,110 @@
+package pacifica
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCancelAllOrdersRequest(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	tests := []struct {
+		name     string
+		params   CancelAllOrdersRequest
+		opts     *CancelAllOrdersOptions
+		wantErr  bool
+		validate func(*testing.T, map[string]interface{})
+	}{
+		{
+			name: "cancel all orders on all symbols",
+			params: CancelAllOrdersRequest{
+				AllSymbols: true,
+			},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				assert.Equal(t, true, req["all_symbols"])
+				assert.Equal(t, false, req["exclude_reduce_only"])
+				assert.NotContains(t, req, "symbol")
+				assert.Contains(t, req, "account")
+				assert.Contains(t, req, "signature")
+				assert.Contains(t, req, "timestamp")
+			},
+		},
+		{
+			name: "cancel all orders on one symbol excluding reduce only",
+			params: CancelAllOrdersRequest{
+				Symbol:            "BTC",
+				ExcludeReduceOnly: true,
+			},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				assert.Equal(t, false, req["all_symbols"])
+				assert.Equal(t, true, req["exclude_reduce_only"])
+				assert.Equal(t, "BTC", req["symbol"])
+			},
+		},
+		{
+			name: "cancel all orders with custom expiry_window",
+			params: CancelAllOrdersRequest{
+				AllSymbols: true,
+			},
+			opts: &CancelAllOrdersOptions{
+				ExpiryWindow: 10000,
+			},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				assert.Equal(t, int64(10000), req["expiry_window"])
+			},
+		},
+		{
+			name:    "missing both all_symbols and symbol",
+			params:  CancelAllOrdersRequest{},
+			wantErr: true,
+		},
+		{
+			name: "both all_symbols and symbol",
+			params: CancelAllOrdersRequest{
+				AllSymbols: true,
+				Symbol:     "BTC",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := signer.BuildCancelAllOrdersRequest(tt.params, tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.validate != nil {
+				tt.validate(t, req)
+			}
+		})
+	}
+}
+
+func TestRESTClientCancelAllOrders(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/orders/cancel_all", r.URL.Path)
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "ETH", body["symbol"])
+
+		_, _ = w.Write([]byte(`{"cancelled_count":3}`))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, signer)
+	response, err := client.CancelAllOrders(CancelAllOrdersRequest{Symbol: "ETH"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, response.CancelledCount)
+}
@@ -1,11 +1,9 @@
 package pacifica
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 )
 
 // CancelOrderRequest represents the request data for canceling an order
@@ -28,6 +26,11 @@ type CancelOrderOptions struct {
 
 // BuildCancelOrderRequest builds a signed request for canceling an order
 func (s *Exchange) BuildCancelOrderRequest(params CancelOrderRequest, opts *CancelOrderOptions) (map[string]interface{}, error) {
+	return buildCancelOrderRequest(context.Background(), s, params, opts)
+}
+
+// buildCancelOrderRequest is BuildCancelOrderRequest passing ctx to the signer
+func buildCancelOrderRequest(ctx context.Context, s *Exchange, params CancelOrderRequest, opts *CancelOrderOptions) (map[string]interface{}, error) {
 	// Validate required fields
 	if params.Symbol == "" {
 		return nil, fmt.Errorf("symbol is required")
@@ -63,7 +66,7 @@ func (s *Exchange) BuildCancelOrderRequest(params CancelOrderRequest, opts *Canc
 	}
 
 	// Build signed request with operation type "cancel_order"
-	request, err := s.BuildSignedRequest("cancel_order", operationData, expiryWindow)
+	request, err := s.BuildSignedRequestWithContext(ctx, "cancel_order", operationData, expiryWindow)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build signed request: %w", err)
 	}
@@ -81,7 +84,9 @@ type CancelOrderResponse struct {
 	Success bool `json:"success"`
 }
 
-// CancelOrderError represents an error response from the API
+// CancelOrderError represents an error response from the API.
+//
+// Deprecated: API errors are returned as *APIError.
 type CancelOrderError struct {
 	Error string `json:"error"`
 	Code  int    `json:"code"`
@@ -89,58 +94,10 @@ type CancelOrderError struct {
 
 // CancelOrder cancels an order on Pacifica
 func (c *RESTClient) CancelOrder(params CancelOrderRequest, opts *CancelOrderOptions) (*CancelOrderResponse, error) {
-	// Build signed request
-	request, err := c.signer.BuildCancelOrderRequest(params, opts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to build signed request: %w", err)
-	}
-
-	// Marshal request to JSON
-	jsonData, err := json.Marshal(request)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	// Create HTTP request
-	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/orders/cancel", bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	// Make the request
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make HTTP request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
+	return c.CancelOrderWithContext(context.Background(), params, opts)
+}
 
-	// Handle different status codes
-	switch resp.StatusCode {
-	case http.StatusOK:
-		var response CancelOrderResponse
-		if err := json.Unmarshal(body, &response); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-		}
-		return &response, nil
-	case http.StatusBadRequest:
-		var apiError CancelOrderError
-		if err := json.Unmarshal(body, &apiError); err != nil {
-			return nil, fmt.Errorf("bad request: %s", string(body))
-		}
-		return nil, fmt.Errorf("API error (code %d): %s", apiError.Code, apiError.Error)
-	default:
-		var apiError CancelOrderError
-		if err := json.Unmarshal(body, &apiError); err == nil {
-			return nil, fmt.Errorf("API error (code %d): %s", apiError.Code, apiError.Error)
-		}
-		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
-	}
+// CancelOrderWithContext is like CancelOrder but aborts the HTTP request when ctx is done
+func (c *RESTClient) CancelOrderWithContext(ctx context.Context, params CancelOrderRequest, opts *CancelOrderOptions) (*CancelOrderResponse, error) {
+	return doSigned[CancelOrderRequest, CancelOrderResponse](ctx, c, "/orders/cancel", buildCancelOrderRequest, params, opts)
 }
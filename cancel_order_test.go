@@ -1,6 +1,10 @@
 package pacifica
 
 import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -192,6 +196,32 @@ func TestRESTClientCancelOrder(t *testing.T) {
 	assert.Equal(t, signer, client.signer)
 }
 
+func TestCancelOrderWithContextCancelled(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	received := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		close(received)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-received
+		cancel()
+	}()
+
+	client := NewRESTClient(server.URL, signer)
+	_, err := client.CancelOrderWithContext(ctx, CancelOrderRequest{
+		Symbol:  "BTC",
+		OrderID: intPtr(12345),
+	}, nil)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
 // Helper function for tests
 func intPtr(i int64) *int64 {
 	return &i
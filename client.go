@@ -1,7 +1,14 @@
 package pacifica
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"time"
 )
 
@@ -12,21 +19,194 @@ const (
 
 // RESTClient handles REST API requests to Pacifica
 type RESTClient struct {
-	baseURL    string
-	httpClient *http.Client
-	signer     *Exchange
+	baseURL     string
+	httpClient  *http.Client
+	signer      *Exchange
+	rateLimiter *RateLimiter
+	retryPolicy *RetryPolicy
+	logger      logger
+	userAgent   string
 }
 
 // NewRESTClient creates a new REST API client
-func NewRESTClient(baseURL string, signer *Exchange) *RESTClient {
+func NewRESTClient(baseURL string, signer *Exchange, opts ...RESTOpt) *RESTClient {
 	if baseURL == "" {
 		baseURL = MainnetAPIURL
 	}
-	return &RESTClient{
+	c := &RESTClient{
 		baseURL: baseURL,
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
 		},
 		signer: signer,
 	}
+	for _, opt := range opts {
+		opt.Apply(c)
+	}
+	return c
+}
+
+// apiEnvelope holds the failure fields shared by the API response envelopes
+type apiEnvelope struct {
+	Success *bool       `json:"success"`
+	Error   interface{} `json:"error"`
+	Code    interface{} `json:"code"`
+}
+
+// doSigned signs params with build, posts the signed request to path and decodes the response into Resp.
+// Every signed endpoint goes through it. The signer of the client is passed ctx while signing.
+func doSigned[Req, Resp, Opts any](ctx context.Context, c *RESTClient, path string, build func(context.Context, *Exchange, Req, Opts) (map[string]interface{}, error), params Req, opts Opts) (*Resp, error) {
+	// The request is signed again when it is resent so that the signature does not expire
+	payload := func() ([]byte, error) {
+		// Build signed request
+		request, err := build(ctx, c.signer, params, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build signed request: %w", err)
+		}
+
+		// Marshal request to JSON
+		jsonData, err := json.Marshal(request)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request: %w", err)
+		}
+		return jsonData, nil
+	}
+
+	body, err := c.do(ctx, http.MethodPost, path, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var response Resp
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return &response, nil
+}
+
+// doGet performs a GET request to path with the query and decodes the response into Resp.
+// Every read endpoint goes through it.
+func doGet[Resp any](ctx context.Context, c *RESTClient, path string, query url.Values) (*Resp, error) {
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	body, err := c.do(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var response Resp
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return &response, nil
+}
+
+// do performs an HTTP request against the API and returns the body of a successful response.
+// The request body is built by payload, nil for requests without one.
+// Non-200 responses and envelopes reporting a failure are returned as *APIError.
+func (c *RESTClient) do(ctx context.Context, method, path string, payload func() ([]byte, error)) ([]byte, error) {
+	var retries, rateLimitRetries int
+	for {
+		body, err := c.doOnce(ctx, method, path, payload)
+		if err == nil {
+			return body, nil
+		}
+
+		var apiErr *APIError
+		if c.rateLimiter != nil && errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusTooManyRequests {
+			retryAfter := apiErr.RetryAfter
+			if retryAfter == 0 {
+				retryAfter = defaultRetryAfter
+			}
+			c.rateLimiter.Pause(retryAfter)
+			if c.rateLimiter.mode == RateLimitBlock && rateLimitRetries < c.rateLimiter.maxRetries {
+				rateLimitRetries++
+				c.logInfof("%s %s: rate limited, retrying after %s", method, path, retryAfter)
+				continue
+			}
+			return nil, err
+		}
+
+		if c.canRetry(method, path) && retries < c.retryPolicy.MaxRetries && isRetryable(ctx, err) {
+			c.logInfof("%s %s: retrying after error: %v", method, path, err)
+			if err := c.retryPolicy.wait(ctx, retries); err != nil {
+				return nil, err
+			}
+			retries++
+			continue
+		}
+		return nil, err
+	}
+}
+
+func (c *RESTClient) doOnce(ctx context.Context, method, path string, payload func() ([]byte, error)) ([]byte, error) {
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.Wait(ctx, path); err != nil {
+			return nil, err
+		}
+	}
+
+	reqBody := io.Reader(http.NoBody)
+	if payload != nil {
+		data, err := payload()
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	// Create HTTP request
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	// Make the request
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make HTTP request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Read response body
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, parseAPIError(resp, body)
+	}
+
+	// Envelopes report failures with success false
+	var envelope apiEnvelope
+	if err := json.Unmarshal(body, &envelope); err == nil && envelope.Success != nil && !*envelope.Success {
+		return nil, envelopeAPIError(resp, envelope.Error, envelope.Code)
+	}
+
+	return body, nil
+}
+
+func (c *RESTClient) logErrf(fmt string, args ...any) {
+	if c.logger == nil {
+		return
+	}
+
+	c.logger.Errorf(fmt, args...)
+}
+
+func (c *RESTClient) logInfof(fmt string, args ...any) {
+	if c.logger == nil {
+		return
+	}
+
+	c.logger.Infof(fmt, args...)
 }
@@ -0,0 +1,170 @@
+package pacifica
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDoSigned(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/orders/create", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "BTC", body["symbol"])
+		assert.Contains(t, body, "signature")
+
+		_, _ = w.Write([]byte(`{"order_id":12345}`))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, signer)
+	params := CreateLimitOrderRequest{
+		Symbol: "BTC",
+		Price:  "50000",
+		Amount: "0.1",
+		Side:   SideBid,
+		TIF:    TIFGTC,
+	}
+
+	response, err := doSigned[CreateLimitOrderRequest, CreateLimitOrderResponse](context.Background(), client, "/orders/create", buildCreateLimitOrderRequest, params, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12345), response.OrderID)
+
+	// Build failures are returned before any request is sent
+	buildErr := errors.New("build failed")
+	_, err = doSigned[CreateLimitOrderRequest, CreateLimitOrderResponse](context.Background(), client, "/orders/create",
+		func(context.Context, *Exchange, CreateLimitOrderRequest, *CreateLimitOrderOptions) (map[string]interface{}, error) {
+			return nil, buildErr
+		}, params, nil)
+	assert.ErrorIs(t, err, buildErr)
+	assert.Equal(t, 1, requests)
+}
+
+func TestDoGet(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		switch r.URL.Query().Get("account") {
+		case "ok":
+			_, _ = w.Write([]byte(`{"success":true,"data":[{"symbol":"BTC"}],"error":null,"code":null}`))
+		case "failed":
+			_, _ = w.Write([]byte(`{"success":false,"data":null,"error":"Account not found","code":1}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`internal error`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, nil)
+
+	response, err := doGet[positionsResponse](context.Background(), client, "/positions", url.Values{"account": {"ok"}})
+	require.NoError(t, err)
+	require.Len(t, response.Data, 1)
+	assert.Equal(t, "BTC", response.Data[0].Symbol)
+
+	var apiErr *APIError
+	_, err = doGet[positionsResponse](context.Background(), client, "/positions", url.Values{"account": {"failed"}})
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, CodeAccountNotFound, apiErr.Code)
+
+	_, err = doGet[positionsResponse](context.Background(), client, "/positions", url.Values{"account": {"other"}})
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusInternalServerError, apiErr.StatusCode)
+	assert.Equal(t, "internal error", apiErr.Message)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+type testLogger struct {
+	infos  []string
+	errors []string
+}
+
+func (l *testLogger) Infof(format string, args ...any) {
+	l.infos = append(l.infos, fmt.Sprintf(format, args...))
+}
+
+func (l *testLogger) Errorf(format string, args ...any) {
+	l.errors = append(l.errors, fmt.Sprintf(format, args...))
+}
+
+func TestRESTClientOptions(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	var requests int
+	var requestBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "pacifica-bot/1.0", r.Header.Get("User-Agent"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&requestBody))
+		if requests == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(`{"success":true}`))
+	}))
+	defer server.Close()
+
+	var roundTrips int
+	httpClient := &http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			roundTrips++
+			return http.DefaultTransport.RoundTrip(r)
+		}),
+	}
+	log := &testLogger{}
+
+	client := NewRESTClient(server.URL, signer,
+		WithHTTPClient(httpClient),
+		WithUserAgent("pacifica-bot/1.0"),
+		WithDefaultExpiryWindow(5000),
+		WithLogger(log),
+		WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond}),
+	)
+
+	response, err := client.CancelOrder(CancelOrderRequest{Symbol: "BTC", OrderID: intPtr(12345)}, nil)
+	require.NoError(t, err)
+	assert.True(t, response.Success)
+	assert.Equal(t, 2, roundTrips)
+	assert.Equal(t, float64(5000), requestBody["expiry_window"])
+	require.Len(t, log.infos, 1)
+	assert.Contains(t, log.infos[0], "POST /orders/cancel: retrying after error")
+
+	// An explicit expiry window takes precedence over the default
+	_, err = client.CancelOrder(CancelOrderRequest{Symbol: "BTC", OrderID: intPtr(12345)}, &CancelOrderOptions{ExpiryWindow: 10000})
+	require.NoError(t, err)
+	assert.Equal(t, float64(10000), requestBody["expiry_window"])
+
+	// The default expiry window does not leak into the shared signer
+	request, err := signer.BuildCancelOrderRequest(CancelOrderRequest{Symbol: "BTC", OrderID: intPtr(12345)}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(defaultExpiryWindow), request["expiry_window"])
+
+	// Non-positive windows and clients without a signer are left unchanged
+	for _, expiryWindow := range []int64{0, -1} {
+		client := NewRESTClient(server.URL, signer, WithDefaultExpiryWindow(expiryWindow))
+		assert.Same(t, signer, client.signer)
+	}
+	assert.Nil(t, NewRESTClient(server.URL, nil, WithDefaultExpiryWindow(5000)).signer)
+}
@@ -0,0 +1,138 @@
+package pacifica
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Sentinel errors matched by *APIError and *BatchActionError with errors.Is
+var (
+	ErrInsufficientMargin = errors.New("insufficient margin")
+	ErrOrderNotFound      = errors.New("order not found")
+	ErrRateLimited        = errors.New("rate limited")
+	// ErrSignatureExpired is matched on the error message since the API has no code for it,
+	// see signatureExpiredMessage
+	ErrSignatureExpired = errors.New("signature expired")
+)
+
+// signatureExpiredMessage is the message of the API rejecting a request whose signature expired.
+// Errors whose message contains it, regardless of case, match ErrSignatureExpired.
+const signatureExpiredMessage = "signature expired"
+
+// Pacifica API error codes
+const (
+	CodeUnknown                   = 0
+	CodeAccountNotFound           = 1
+	CodeBookNotFound              = 2
+	CodeInvalidTickLevel          = 3
+	CodeInsufficientBalance       = 4
+	CodeOrderNotFound             = 5
+	CodeOverWithdrawal            = 6
+	CodeInvalidLeverage           = 7
+	CodeCannotUpdateMargin        = 8
+	CodePositionNotFound          = 9
+	CodePositionTPSLLimitExceeded = 10
+)
+
+// apiErrorCodes maps exchange error codes to sentinel errors
+var apiErrorCodes = map[int]error{
+	CodeInsufficientBalance: ErrInsufficientMargin,
+	CodeOrderNotFound:       ErrOrderNotFound,
+}
+
+// requestIDHeader is the response header carrying the request ID assigned by the API
+const requestIDHeader = "X-Request-Id"
+
+// APIError is an error response returned by the Pacifica API
+type APIError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// Code is the exchange error code, CodeUnknown when the response has none
+	Code int
+	// Message is the error message, or the raw response body when it could not be decoded
+	Message string
+	// RequestID is the request ID assigned by the API, empty when not provided
+	RequestID string
+	// RetryAfter is the delay requested by the Retry-After header of a 429 response
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	var msg string
+	if e.Code != CodeUnknown {
+		msg = fmt.Sprintf("API error (code %d): %s", e.Code, e.Message)
+	} else {
+		msg = fmt.Sprintf("API error (status %d): %s", e.StatusCode, e.Message)
+	}
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" (request %s)", e.RequestID)
+	}
+	return msg
+}
+
+// Is reports whether the error matches one of the sentinel errors
+func (e *APIError) Is(target error) bool {
+	if target == ErrRateLimited {
+		return e.StatusCode == http.StatusTooManyRequests
+	}
+	return matchesSentinel(e.Code, e.Message, target)
+}
+
+// matchesSentinel reports whether an error with the exchange code and message matches the sentinel target
+func matchesSentinel(code int, message string, target error) bool {
+	if target == ErrSignatureExpired {
+		return strings.Contains(strings.ToLower(message), signatureExpiredMessage)
+	}
+	sentinel, ok := apiErrorCodes[code]
+	return ok && sentinel == target
+}
+
+// newAPIError creates an APIError from a response, the body is used when the message is empty
+func newAPIError(resp *http.Response, code int, message string, body []byte) *APIError {
+	if message == "" {
+		message = string(body)
+	}
+	apiError := &APIError{
+		StatusCode: resp.StatusCode,
+		Code:       code,
+		Message:    message,
+		RequestID:  resp.Header.Get(requestIDHeader),
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		apiError.RetryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+	}
+	return apiError
+}
+
+// parseAPIError creates an APIError from the body of an unsuccessful response
+func parseAPIError(resp *http.Response, body []byte) *APIError {
+	var apiError struct {
+		Error string `json:"error"`
+		Code  int    `json:"code"`
+	}
+	if err := json.Unmarshal(body, &apiError); err != nil {
+		return newAPIError(resp, CodeUnknown, "", body)
+	}
+	return newAPIError(resp, apiError.Code, apiError.Error, body)
+}
+
+// envelopeAPIError creates an APIError from the error and code of an unsuccessful response envelope
+func envelopeAPIError(resp *http.Response, errValue, codeValue interface{}) *APIError {
+	message := ""
+	if errValue != nil {
+		message = fmt.Sprint(errValue)
+	}
+	return newAPIError(resp, envelopeCode(codeValue), message, nil)
+}
+
+// envelopeCode returns the exchange error code of a decoded code field, CodeUnknown when it is not a number
+func envelopeCode(codeValue interface{}) int {
+	if c, ok := codeValue.(float64); ok {
+		return int(c)
+	}
+	return CodeUnknown
+}
@@ -0,0 +1,135 @@
+package pacifica
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIErrorIs(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    *APIError
+		target error
+		want   bool
+	}{
+		{
+			name:   "insufficient margin",
+			err:    &APIError{StatusCode: http.StatusBadRequest, Code: CodeInsufficientBalance, Message: "Insufficient balance"},
+			target: ErrInsufficientMargin,
+			want:   true,
+		},
+		{
+			name:   "order not found",
+			err:    &APIError{StatusCode: http.StatusBadRequest, Code: CodeOrderNotFound, Message: "Order not found"},
+			target: ErrOrderNotFound,
+			want:   true,
+		},
+		{
+			name:   "rate limited",
+			err:    &APIError{StatusCode: http.StatusTooManyRequests, Message: "Too many requests"},
+			target: ErrRateLimited,
+			want:   true,
+		},
+		{
+			name:   "signature expired",
+			err:    &APIError{StatusCode: http.StatusBadRequest, Message: "Signature expired"},
+			target: ErrSignatureExpired,
+			want:   true,
+		},
+		{
+			name:   "signature expired within a longer message",
+			err:    &APIError{StatusCode: http.StatusBadRequest, Message: "Verification failed: signature expired"},
+			target: ErrSignatureExpired,
+			want:   true,
+		},
+		{
+			name:   "different code",
+			err:    &APIError{StatusCode: http.StatusBadRequest, Code: CodeOrderNotFound, Message: "Order not found"},
+			target: ErrInsufficientMargin,
+			want:   false,
+		},
+		{
+			name:   "order expired is not a signature error",
+			err:    &APIError{StatusCode: http.StatusBadRequest, Message: "Order expired"},
+			target: ErrSignatureExpired,
+			want:   false,
+		},
+		{
+			name:   "invalid signature is not an expired signature",
+			err:    &APIError{StatusCode: http.StatusBadRequest, Message: "Invalid signature"},
+			target: ErrSignatureExpired,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errors.Is(tt.err, tt.target))
+		})
+	}
+}
+
+func TestAPIErrorMessage(t *testing.T) {
+	err := &APIError{StatusCode: http.StatusBadRequest, Code: CodeOrderNotFound, Message: "Order not found"}
+	assert.Equal(t, "API error (code 5): Order not found", err.Error())
+
+	err = &APIError{StatusCode: http.StatusBadGateway, Message: "bad gateway", RequestID: "req-1"}
+	assert.Equal(t, "API error (status 502): bad gateway (request req-1)", err.Error())
+}
+
+func TestRESTClientAPIErrors(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIDHeader, "req-42")
+		switch r.URL.Path {
+		case "/orders/create":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"Insufficient balance","code":4}`))
+		case "/orders/cancel":
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`Too Many Requests`))
+		case "/positions":
+			_, _ = w.Write([]byte(`{"success":false,"data":null,"error":"Account not found","code":1}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, signer)
+
+	_, err := client.CreateLimitOrder(CreateLimitOrderRequest{
+		Symbol: "BTC",
+		Price:  "50000",
+		Amount: "0.1",
+		Side:   SideBid,
+		TIF:    TIFGTC,
+	}, nil)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrInsufficientMargin)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+	assert.Equal(t, CodeInsufficientBalance, apiErr.Code)
+	assert.Equal(t, "Insufficient balance", apiErr.Message)
+	assert.Equal(t, "req-42", apiErr.RequestID)
+
+	_, err = client.CancelOrder(CancelOrderRequest{Symbol: "BTC", OrderID: intPtr(12345)}, nil)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrRateLimited)
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "Too Many Requests", apiErr.Message)
+
+	_, err = client.GetPositions(context.Background(), testAccountID)
+	require.Error(t, err)
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, CodeAccountNotFound, apiErr.Code)
+	assert.Equal(t, "Account not found", apiErr.Message)
+}
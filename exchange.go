@@ -1,6 +1,7 @@
 package pacifica
 
 import (
+	"context"
 	"crypto/ed25519"
 	"encoding/json"
 	"fmt"
@@ -10,6 +11,9 @@ import (
 	"github.com/mr-tron/base58"
 )
 
+// defaultExpiryWindow is the expiry window in milliseconds of signatures created without one
+const defaultExpiryWindow = 30000
+
 // SignatureHeader represents the header structure for Pacifica API signing
 type SignatureHeader struct {
 	Timestamp    int64  `json:"timestamp"`
@@ -27,37 +31,129 @@ type SignedRequest struct {
 	Data         interface{} `json:"-"` // This will be flattened into the request
 }
 
-// Exchange handles Pacifica API signature generation
+// Exchange handles Pacifica API signature generation.
+// It signs either as the account owner, in which case agent_wallet is null,
+// or as an agent key bound to the account, in which case agent_wallet is the agent public key.
 type Exchange struct {
-	accountID  string
-	privateKey ed25519.PrivateKey
-	publicKey  ed25519.PublicKey
+	accountID    string
+	signer       Signer
+	publicKey    ed25519.PublicKey
+	agent        bool
+	expiryWindow int64
 }
 
-// NewExchange creates a new signer instance from a base58 encoded private key
+// NewExchange creates a new signer instance from a base58 encoded private key.
+// The signer acts as the account owner when accountID is empty or equals its public key
+// and as an agent of accountID otherwise.
 func NewExchange(privateKeyBase58 string, accountID string) (*Exchange, error) {
-	// Decode base58 private key
-	privateKeyBytes, err := base58.Decode(privateKeyBase58)
+	signer, err := newBase58Signer(privateKeyBase58)
 	if err != nil {
-		return nil, fmt.Errorf("failed to decode private key: %w", err)
+		return nil, err
+	}
+	return NewExchangeWithSigner(signer, accountID)
+}
+
+// NewOwnerExchange creates a signer for the account owned by the private key
+func NewOwnerExchange(privateKeyBase58 string) (*Exchange, error) {
+	return NewExchange(privateKeyBase58, "")
+}
+
+// NewAgentExchange creates a signer for an agent key bound to accountID
+func NewAgentExchange(agentPrivateKeyBase58 string, accountID string) (*Exchange, error) {
+	signer, err := newBase58Signer(agentPrivateKeyBase58)
+	if err != nil {
+		return nil, err
+	}
+	return NewAgentExchangeWithSigner(signer, accountID)
+}
+
+// NewExchangeWithSigner creates a new signer instance backed by signer, following the same
+// owner and agent rules as NewExchange
+func NewExchangeWithSigner(signer Signer, accountID string) (*Exchange, error) {
+	s, err := newExchange(signer)
+	if err != nil {
+		return nil, err
+	}
+
+	if accountID == "" {
+		accountID = s.GetPublicKey()
+	}
+	s.accountID = accountID
+	s.agent = accountID != s.GetPublicKey()
+
+	return s, nil
+}
+
+// NewAgentExchangeWithSigner creates a signer for an agent key bound to accountID backed by signer
+func NewAgentExchangeWithSigner(signer Signer, accountID string) (*Exchange, error) {
+	if accountID == "" {
+		return nil, fmt.Errorf("account id is required")
+	}
+
+	s, err := newExchange(signer)
+	if err != nil {
+		return nil, err
+	}
+	if accountID == s.GetPublicKey() {
+		return nil, fmt.Errorf("agent key must differ from the account key")
+	}
+
+	s.accountID = accountID
+	s.agent = true
+
+	return s, nil
+}
+
+func newExchange(signer Signer) (*Exchange, error) {
+	if signer == nil {
+		return nil, fmt.Errorf("signer is required")
 	}
 
-	// Convert to ed25519 private key
-	privateKey := ed25519.PrivateKey(privateKeyBytes)
-	publicKey := privateKey.Public().(ed25519.PublicKey)
+	publicKey := signer.PublicKey()
+	if len(publicKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid public key length: %d", len(publicKey))
+	}
 
 	return &Exchange{
-		accountID:  accountID,
-		privateKey: privateKey,
-		publicKey:  publicKey,
+		signer:    signer,
+		publicKey: publicKey,
 	}, nil
 }
 
+// newBase58Signer creates an in-memory signer from a base58 encoded private key
+func newBase58Signer(privateKeyBase58 string) (Signer, error) {
+	// Decode base58 private key
+	privateKeyBytes, err := base58.Decode(privateKeyBase58)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode private key: %w", err)
+	}
+
+	return NewPrivateKeySigner(ed25519.PrivateKey(privateKeyBytes))
+}
+
 // GetPublicKey returns the base58 encoded public key
 func (s *Exchange) GetPublicKey() string {
 	return base58.Encode(s.publicKey)
 }
 
+// AccountID returns the account the signer acts for
+func (s *Exchange) AccountID() string {
+	return s.accountID
+}
+
+// IsAgent reports whether the signer is an agent key rather than the account owner key
+func (s *Exchange) IsAgent() bool {
+	return s.agent
+}
+
+// agentWallet returns the agent_wallet value of signed requests, nil when signing as the owner
+func (s *Exchange) agentWallet() interface{} {
+	if !s.agent {
+		return nil
+	}
+	return s.GetPublicKey()
+}
+
 // sortJSONKeys recursively sorts all keys in a JSON structure
 func sortJSONKeys(value interface{}) interface{} {
 	switch v := value.(type) {
@@ -100,13 +196,16 @@ func createCompactJSON(data interface{}) (string, error) {
 	return string(jsonBytes), nil
 }
 
-// signMessage signs a message using the private key
-func (s *Exchange) signMessage(message string) (string, error) {
+// signMessage signs a message using the signer
+func (s *Exchange) signMessage(ctx context.Context, message string) (string, error) {
 	// Convert message to bytes
 	messageBytes := []byte(message)
 
 	// Sign the message
-	signature := ed25519.Sign(s.privateKey, messageBytes)
+	signature, err := s.signer.Sign(ctx, messageBytes)
+	if err != nil {
+		return "", err
+	}
 
 	// Convert signature to base58
 	signatureBase58 := base58.Encode(signature)
@@ -116,12 +215,22 @@ func (s *Exchange) signMessage(message string) (string, error) {
 
 // CreateSignature creates a signature for the given operation data
 func (s *Exchange) CreateSignature(operationType string, operationData interface{}, expiryWindow int64) (*SignatureHeader, string, error) {
-	// Get current timestamp in milliseconds
-	timestamp := time.Now().UnixMilli()
+	return s.CreateSignatureWithContext(context.Background(), operationType, operationData, expiryWindow)
+}
 
+// CreateSignatureWithContext creates a signature for the given operation data, passing ctx to the signer
+func (s *Exchange) CreateSignatureWithContext(ctx context.Context, operationType string, operationData interface{}, expiryWindow int64) (*SignatureHeader, string, error) {
+	return s.createSignatureAt(ctx, operationType, operationData, time.Now().UnixMilli(), expiryWindow)
+}
+
+// createSignatureAt creates a signature for the given operation data at a fixed timestamp
+func (s *Exchange) createSignatureAt(ctx context.Context, operationType string, operationData interface{}, timestamp int64, expiryWindow int64) (*SignatureHeader, string, error) {
 	// Use default expiry window if not provided
 	if expiryWindow == 0 {
-		expiryWindow = 30000 // 30 seconds default
+		expiryWindow = s.expiryWindow
+	}
+	if expiryWindow == 0 {
+		expiryWindow = defaultExpiryWindow // 30 seconds default
 	}
 
 	// Create signature header
@@ -146,7 +255,7 @@ func (s *Exchange) CreateSignature(operationType string, operationData interface
 	}
 
 	// Sign the message
-	signature, err := s.signMessage(compactJSON)
+	signature, err := s.signMessage(ctx, compactJSON)
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to sign message: %w", err)
 	}
@@ -156,8 +265,13 @@ func (s *Exchange) CreateSignature(operationType string, operationData interface
 
 // BuildSignedRequest builds the final request with authentication headers
 func (s *Exchange) BuildSignedRequest(operationType string, operationData interface{}, expiryWindow int64) (map[string]interface{}, error) {
+	return s.BuildSignedRequestWithContext(context.Background(), operationType, operationData, expiryWindow)
+}
+
+// BuildSignedRequestWithContext builds the final request with authentication headers, passing ctx to the signer
+func (s *Exchange) BuildSignedRequestWithContext(ctx context.Context, operationType string, operationData interface{}, expiryWindow int64) (map[string]interface{}, error) {
 	// Create signature
-	header, signature, err := s.CreateSignature(operationType, operationData, expiryWindow)
+	header, signature, err := s.CreateSignatureWithContext(ctx, operationType, operationData, expiryWindow)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create signature: %w", err)
 	}
@@ -180,7 +294,7 @@ func (s *Exchange) BuildSignedRequest(operationType string, operationData interf
 	// Build final request
 	request := map[string]interface{}{
 		"account":       s.accountID,
-		"agent_wallet":  s.GetPublicKey(),
+		"agent_wallet":  s.agentWallet(),
 		"signature":     signature,
 		"timestamp":     header.Timestamp,
 		"expiry_window": header.ExpiryWindow,
@@ -1,6 +1,7 @@
 package pacifica
 
 import (
+	"context"
 	"crypto/ed25519"
 	"encoding/json"
 	"testing"
@@ -24,6 +25,16 @@ func generateTestExchange(t *testing.T) *Exchange {
 	return signer
 }
 
+// generateTestOwnerExchange creates an Exchange whose account is its own public key
+func generateTestOwnerExchange(t *testing.T) *Exchange {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	signer, err := NewExchange(base58.Encode(privateKey), base58.Encode(publicKey))
+	require.NoError(t, err)
+	return signer
+}
+
 func TestNewSigner(t *testing.T) {
 	// Test with valid private key
 	signer := generateTestExchange(t)
@@ -33,6 +44,18 @@ func TestNewSigner(t *testing.T) {
 	// Test with invalid private key
 	_, err := NewExchange("invalid_key", testAccountID)
 	assert.Error(t, err)
+
+	// Test with valid base58 of the wrong length
+	_, err = NewExchange(base58.Encode([]byte("too short")), testAccountID)
+	assert.Error(t, err)
+
+	// Test with a public key that does not belong to the private key
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	corrupted := append(ed25519.PrivateKey{}, privateKey...)
+	corrupted[ed25519.PrivateKeySize-1] ^= 0xff
+	_, err = NewExchange(base58.Encode(corrupted), testAccountID)
+	assert.Error(t, err)
 }
 
 func TestSortJSONKeys(t *testing.T) {
@@ -254,7 +277,7 @@ func TestVerifySignature(t *testing.T) {
 	signer := generateTestExchange(t)
 
 	message := "test message"
-	signature, err := signer.signMessage(message)
+	signature, err := signer.signMessage(context.Background(), message)
 	require.NoError(t, err)
 
 	// Verify correct signature
@@ -350,3 +373,83 @@ func TestComplexOperationData(t *testing.T) {
 	assert.Equal(t, "1.0", metadata["version"])
 	assert.Contains(t, metadata, "tags")
 }
+
+func TestExchangeSigningModes(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	privateKeyBase58 := base58.Encode(privateKey)
+	publicKeyBase58 := base58.Encode(publicKey)
+
+	operationData := map[string]interface{}{"symbol": "BTC"}
+
+	tests := []struct {
+		name        string
+		newExchange func() (*Exchange, error)
+		wantErr     bool
+		wantAccount string
+		wantAgent   bool
+	}{
+		{
+			name:        "owner via NewOwnerExchange",
+			newExchange: func() (*Exchange, error) { return NewOwnerExchange(privateKeyBase58) },
+			wantAccount: publicKeyBase58,
+		},
+		{
+			name:        "owner via NewExchange with own public key",
+			newExchange: func() (*Exchange, error) { return NewExchange(privateKeyBase58, publicKeyBase58) },
+			wantAccount: publicKeyBase58,
+		},
+		{
+			name:        "owner via NewExchange without account",
+			newExchange: func() (*Exchange, error) { return NewExchange(privateKeyBase58, "") },
+			wantAccount: publicKeyBase58,
+		},
+		{
+			name:        "agent via NewExchange",
+			newExchange: func() (*Exchange, error) { return NewExchange(privateKeyBase58, testAccountID) },
+			wantAccount: testAccountID,
+			wantAgent:   true,
+		},
+		{
+			name:        "agent via NewAgentExchange",
+			newExchange: func() (*Exchange, error) { return NewAgentExchange(privateKeyBase58, testAccountID) },
+			wantAccount: testAccountID,
+			wantAgent:   true,
+		},
+		{
+			name:        "agent without account",
+			newExchange: func() (*Exchange, error) { return NewAgentExchange(privateKeyBase58, "") },
+			wantErr:     true,
+		},
+		{
+			name:        "agent for its own account",
+			newExchange: func() (*Exchange, error) { return NewAgentExchange(privateKeyBase58, publicKeyBase58) },
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer, err := tt.newExchange()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAccount, signer.AccountID())
+			assert.Equal(t, tt.wantAgent, signer.IsAgent())
+
+			request, err := signer.BuildSignedRequest("create_order", operationData, 0)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAccount, request["account"])
+
+			body, err := json.Marshal(request)
+			require.NoError(t, err)
+			if tt.wantAgent {
+				assert.Contains(t, string(body), `"agent_wallet":"`+publicKeyBase58+`"`)
+			} else {
+				assert.Contains(t, string(body), `"agent_wallet":null`)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package pacifica
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// FundingPayment represents a funding payment of an account for a single funding interval
+type FundingPayment struct {
+	HistoryID int64     `json:"history_id"`
+	Symbol    string    `json:"symbol"`
+	Side      OrderSide `json:"side"`
+	Amount    string    `json:"amount"`
+	Payout    string    `json:"payout"`
+	Rate      string    `json:"rate"`
+	CreatedAt int64     `json:"created_at"`
+}
+
+// FundingRate represents the funding rate of a market for a single funding interval
+type FundingRate struct {
+	OraclePrice     string `json:"oracle_price"`
+	BidImpactPrice  string `json:"bid_impact_price"`
+	AskImpactPrice  string `json:"ask_impact_price"`
+	FundingRate     string `json:"funding_rate"`
+	NextFundingRate string `json:"next_funding_rate"`
+	CreatedAt       int64  `json:"created_at"`
+}
+
+// FundingHistoryParams contains the filter and pagination parameters for the funding history queries.
+// Zero values are not sent, Symbol is ignored by the funding rate history query.
+type FundingHistoryParams struct {
+	Symbol    string
+	StartTime time.Time
+	EndTime   time.Time
+	Cursor    string
+	Limit     int
+}
+
+func (p FundingHistoryParams) query() url.Values {
+	query := url.Values{}
+	if !p.StartTime.IsZero() {
+		query.Set("start_time", strconv.FormatInt(p.StartTime.UnixMilli(), 10))
+	}
+	if !p.EndTime.IsZero() {
+		query.Set("end_time", strconv.FormatInt(p.EndTime.UnixMilli(), 10))
+	}
+	if p.Cursor != "" {
+		query.Set("cursor", p.Cursor)
+	}
+	if p.Limit > 0 {
+		query.Set("limit", strconv.Itoa(p.Limit))
+	}
+	return query
+}
+
+// FundingHistoryPage represents a single page of the account funding history
+type FundingHistoryPage struct {
+	Payments   []FundingPayment
+	NextCursor string
+	HasMore    bool
+}
+
+type fundingHistoryResponse struct {
+	Success    bool             `json:"success"`
+	Data       []FundingPayment `json:"data"`
+	NextCursor string           `json:"next_cursor"`
+	HasMore    bool             `json:"has_more"`
+	Error      interface{}      `json:"error"`
+	Code       interface{}      `json:"code"`
+}
+
+func (c *RESTClient) GetFundingHistory(ctx context.Context, account string, params FundingHistoryParams) (*FundingHistoryPage, error) {
+	if account == "" {
+		return nil, fmt.Errorf("funding history: account is required")
+	}
+
+	query := params.query()
+	query.Set("account", account)
+	if params.Symbol != "" {
+		query.Set("symbol", params.Symbol)
+	}
+
+	fundingHistoryResp, err := doGet[fundingHistoryResponse](ctx, c, "/funding/history", query)
+	if err != nil {
+		return nil, fmt.Errorf("funding history: %w", err)
+	}
+	return &FundingHistoryPage{
+		Payments:   fundingHistoryResp.Data,
+		NextCursor: fundingHistoryResp.NextCursor,
+		HasMore:    fundingHistoryResp.HasMore,
+	}, nil
+}
+
+// FundingRateHistoryPage represents a single page of the market funding rate history
+type FundingRateHistoryPage struct {
+	Rates      []FundingRate
+	NextCursor string
+	HasMore    bool
+}
+
+type fundingRateHistoryResponse struct {
+	Success    bool          `json:"success"`
+	Data       []FundingRate `json:"data"`
+	NextCursor string        `json:"next_cursor"`
+	HasMore    bool          `json:"has_more"`
+	Error      interface{}   `json:"error"`
+	Code       interface{}   `json:"code"`
+}
+
+func (c *RESTClient) GetFundingRateHistory(ctx context.Context, symbol string, params FundingHistoryParams) (*FundingRateHistoryPage, error) {
+	if symbol == "" {
+		return nil, fmt.Errorf("funding rate history: symbol is required")
+	}
+
+	query := params.query()
+	query.Set("symbol", symbol)
+
+	fundingRateHistoryResp, err := doGet[fundingRateHistoryResponse](ctx, c, "/funding_rate/history", query)
+	if err != nil {
+		return nil, fmt.Errorf("funding rate history: %w", err)
+	}
+	return &FundingRateHistoryPage{
+		Rates:      fundingRateHistoryResp.Data,
+		NextCursor: fundingRateHistoryResp.NextCursor,
+		HasMore:    fundingRateHistoryResp.HasMore,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package pacifica
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRESTClient_GetFundingHistory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/funding/history", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, testAccountID, query.Get("account"))
+		assert.Equal(t, "BTC", query.Get("symbol"))
+		assert.Equal(t, "1759215599000", query.Get("start_time"))
+		assert.Empty(t, query.Get("end_time"))
+		_, _ = w.Write([]byte(`{"success":true,"data":[{"history_id":2287920,"symbol":"BTC","side":"ask",` +
+			`"amount":"0.1","payout":"-0.05","rate":"0.0000125","created_at":1759222804122}],` +
+			`"next_cursor":"11114Lz77","has_more":true,"error":null,"code":null}`))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, nil)
+
+	page, err := client.GetFundingHistory(context.Background(), testAccountID, FundingHistoryParams{
+		Symbol:    "BTC",
+		StartTime: time.UnixMilli(1759215599000),
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Payments, 1)
+	assert.True(t, page.HasMore)
+	assert.Equal(t, "11114Lz77", page.NextCursor)
+	assert.Equal(t, FundingPayment{
+		HistoryID: 2287920,
+		Symbol:    "BTC",
+		Side:      SideAsk,
+		Amount:    "0.1",
+		Payout:    "-0.05",
+		Rate:      "0.0000125",
+		CreatedAt: 1759222804122,
+	}, page.Payments[0])
+
+	_, err = client.GetFundingHistory(context.Background(), "", FundingHistoryParams{})
+	assert.Error(t, err)
+}
+
+func TestRESTClient_GetFundingRateHistory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/funding_rate/history", r.URL.Path)
+		assert.Equal(t, "ETH", r.URL.Query().Get("symbol"))
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		_, _ = w.Write([]byte(`{"success":true,"data":[{"oracle_price":"4500.1","bid_impact_price":"4499.9",` +
+			`"ask_impact_price":"4500.3","funding_rate":"0.0000125","next_funding_rate":"0.0000130",` +
+			`"created_at":1759222804122}],"next_cursor":"","has_more":false,"error":null,"code":null}`))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, nil)
+
+	page, err := client.GetFundingRateHistory(context.Background(), "ETH", FundingHistoryParams{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, page.Rates, 1)
+	assert.False(t, page.HasMore)
+	assert.Equal(t, "0.0000125", page.Rates[0].FundingRate)
+	assert.Equal(t, "0.0000130", page.Rates[0].NextFundingRate)
+
+	_, err = client.GetFundingRateHistory(context.Background(), "", FundingHistoryParams{})
+	assert.Error(t, err)
+}
@@ -2,9 +2,7 @@ package pacifica
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
 )
 
 type marketInfoResponse struct {
@@ -29,27 +27,24 @@ type SymbolInfo struct {
 }
 
 func (c *RESTClient) GetMarketInfo(ctx context.Context) ([]SymbolInfo, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/info", http.NoBody)
+	marketInfoResp, err := doGet[marketInfoResponse](ctx, c, "/info", nil)
 	if err != nil {
-		return nil, fmt.Errorf("info: error creating request: %w", err)
+		return nil, fmt.Errorf("info: %w", err)
 	}
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("info: error performing request: %w", err)
-	}
-	defer resp.Body.Close()
+	return marketInfoResp.Data, nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("info: unexpected status code: %d", resp.StatusCode)
-	}
+type pricesResponse struct {
+	Success bool        `json:"success"`
+	Data    Prices      `json:"data"`
+	Error   interface{} `json:"error"`
+	Code    interface{} `json:"code"`
+}
 
-	var marketInfoResp marketInfoResponse
-	err = json.NewDecoder(resp.Body).Decode(&marketInfoResp)
+func (c *RESTClient) GetPrices(ctx context.Context) (Prices, error) {
+	pricesResp, err := doGet[pricesResponse](ctx, c, "/info/prices", nil)
 	if err != nil {
-		return nil, fmt.Errorf("info: error decoding response: %w", err)
+		return nil, fmt.Errorf("prices: %w", err)
 	}
-	if !marketInfoResp.Success {
-		return nil, fmt.Errorf("info: api error: %v", marketInfoResp.Error)
-	}
-	return marketInfoResp.Data, nil
+	return pricesResp.Data, nil
 }
@@ -2,9 +2,12 @@ package pacifica
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRESTClient_GetMarketInfo(t *testing.T) {
@@ -16,3 +19,32 @@ func TestRESTClient_GetMarketInfo(t *testing.T) {
 	assert.NotNil(t, marketInfo)
 	assert.NotEqual(t, len(marketInfo), 0)
 }
+
+func TestRESTClient_GetPrices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/info/prices", r.URL.Path)
+		_, _ = w.Write([]byte(`{"success":true,"data":[{"funding":"0.00010529","mark":"1.084819",` +
+			`"mid":"1.08615","next_funding":"0.00011096","open_interest":"3634796","oracle":"1.084524",` +
+			`"symbol":"XPL","timestamp":1759222967974,"volume_24h":"20896698.0672",` +
+			`"yesterday_price":"1.3412"}],"error":null,"code":null}`))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, nil)
+
+	prices, err := client.GetPrices(context.Background())
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	assert.Equal(t, Price{
+		Funding:        "0.00010529",
+		Mark:           "1.084819",
+		Mid:            "1.08615",
+		NextFunding:    "0.00011096",
+		OpenInterest:   "3634796",
+		Oracle:         "1.084524",
+		Symbol:         "XPL",
+		Timestamp:      1759222967974,
+		Volume24H:      "20896698.0672",
+		YesterdayPrice: "1.3412",
+	}, prices[0])
+}
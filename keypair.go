@@ -0,0 +1,86 @@
+package pacifica
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Environment variables read by NewExchangeFromEnv
+const (
+	EnvPrivateKey  = "PACIFICA_PRIVATE_KEY"
+	EnvKeypairPath = "PACIFICA_KEYPAIR_PATH"
+	EnvAccount     = "PACIFICA_ACCOUNT"
+)
+
+// NewExchangeFromKeypairFile creates a new signer instance from a Solana CLI keypair file,
+// a JSON array of the 64 private key bytes. accountID follows the same rules as NewExchange.
+func NewExchangeFromKeypairFile(path string, accountID string) (*Exchange, error) {
+	privateKey, err := readSolanaKeypairFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := NewPrivateKeySigner(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return NewExchangeWithSigner(signer, accountID)
+}
+
+// NewExchangeFromEnv creates a new signer instance from the environment.
+// The key is read from PACIFICA_PRIVATE_KEY as base58 or from the keypair file at
+// PACIFICA_KEYPAIR_PATH, exactly one of them must be set. PACIFICA_ACCOUNT is optional
+// and follows the same rules as the accountID of NewExchange.
+func NewExchangeFromEnv() (*Exchange, error) {
+	privateKeyBase58 := os.Getenv(EnvPrivateKey)
+	keypairPath := os.Getenv(EnvKeypairPath)
+	accountID := os.Getenv(EnvAccount)
+
+	switch {
+	case privateKeyBase58 != "" && keypairPath != "":
+		return nil, fmt.Errorf("only one of %s and %s may be set", EnvPrivateKey, EnvKeypairPath)
+	case privateKeyBase58 != "":
+		return NewExchange(privateKeyBase58, accountID)
+	case keypairPath != "":
+		return NewExchangeFromKeypairFile(keypairPath, accountID)
+	default:
+		return nil, fmt.Errorf("either %s or %s is required", EnvPrivateKey, EnvKeypairPath)
+	}
+}
+
+// readSolanaKeypairFile reads a Solana CLI keypair file
+func readSolanaKeypairFile(path string) (ed25519.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keypair file: %w", err)
+	}
+
+	privateKey, err := parseSolanaKeypair(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid keypair file %s: %w", path, err)
+	}
+	return privateKey, nil
+}
+
+// parseSolanaKeypair parses a JSON array of the 64 private key bytes
+func parseSolanaKeypair(data []byte) (ed25519.PrivateKey, error) {
+	var values []int
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal keypair: %w", err)
+	}
+	if len(values) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid keypair length: got %d bytes, want %d", len(values), ed25519.PrivateKeySize)
+	}
+
+	privateKey := make(ed25519.PrivateKey, ed25519.PrivateKeySize)
+	for i, v := range values {
+		if v < 0 || v > 255 {
+			return nil, fmt.Errorf("invalid keypair byte at index %d: %d", i, v)
+		}
+		privateKey[i] = byte(v)
+	}
+
+	return privateKey, nil
+}
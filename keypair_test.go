@@ -0,0 +1,144 @@
+package pacifica
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestKeypairFile writes privateKey in the Solana CLI keypair format
+func writeTestKeypairFile(t *testing.T, privateKey []byte) string {
+	values := make([]int, len(privateKey))
+	for i, b := range privateKey {
+		values[i] = int(b)
+	}
+	data, err := json.Marshal(values)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "id.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestNewExchangeFromKeypairFile(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	path := writeTestKeypairFile(t, privateKey)
+
+	signer, err := NewExchangeFromKeypairFile(path, "")
+	require.NoError(t, err)
+	assert.Equal(t, base58.Encode(publicKey), signer.GetPublicKey())
+	assert.Equal(t, base58.Encode(publicKey), signer.AccountID())
+	assert.False(t, signer.IsAgent())
+
+	agent, err := NewExchangeFromKeypairFile(path, testAccountID)
+	require.NoError(t, err)
+	assert.True(t, agent.IsAgent())
+
+	_, err = NewExchangeFromKeypairFile(filepath.Join(t.TempDir(), "missing.json"), "")
+	assert.Error(t, err)
+}
+
+func TestParseSolanaKeypair(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name:    "not json",
+			data:    "not json",
+			wantErr: true,
+		},
+		{
+			name:    "too short",
+			data:    "[1,2,3]",
+			wantErr: true,
+		},
+		{
+			name: "byte out of range",
+			data: func() string {
+				values := make([]int, ed25519.PrivateKeySize)
+				values[0] = 256
+				data, _ := json.Marshal(values)
+				return string(data)
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "valid keypair",
+			data: func() string {
+				values := make([]int, len(privateKey))
+				for i, b := range privateKey {
+					values[i] = int(b)
+				}
+				data, _ := json.Marshal(values)
+				return string(data)
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := parseSolanaKeypair([]byte(tt.data))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, privateKey, key)
+		})
+	}
+}
+
+func TestNewExchangeFromEnv(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	path := writeTestKeypairFile(t, privateKey)
+
+	t.Run("private key", func(t *testing.T) {
+		t.Setenv(EnvPrivateKey, base58.Encode(privateKey))
+		t.Setenv(EnvKeypairPath, "")
+		t.Setenv(EnvAccount, testAccountID)
+
+		signer, err := NewExchangeFromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, base58.Encode(publicKey), signer.GetPublicKey())
+		assert.Equal(t, testAccountID, signer.AccountID())
+	})
+
+	t.Run("keypair path", func(t *testing.T) {
+		t.Setenv(EnvPrivateKey, "")
+		t.Setenv(EnvKeypairPath, path)
+		t.Setenv(EnvAccount, "")
+
+		signer, err := NewExchangeFromEnv()
+		require.NoError(t, err)
+		assert.Equal(t, base58.Encode(publicKey), signer.AccountID())
+	})
+
+	t.Run("both set", func(t *testing.T) {
+		t.Setenv(EnvPrivateKey, base58.Encode(privateKey))
+		t.Setenv(EnvKeypairPath, path)
+
+		_, err := NewExchangeFromEnv()
+		assert.Error(t, err)
+	})
+
+	t.Run("none set", func(t *testing.T) {
+		t.Setenv(EnvPrivateKey, "")
+		t.Setenv(EnvKeypairPath, "")
+
+		_, err := NewExchangeFromEnv()
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,95 @@
+package pacifica
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+)
+
+var intervalDurations = map[string]time.Duration{
+	"1m":  time.Minute,
+	"3m":  3 * time.Minute,
+	"5m":  5 * time.Minute,
+	"15m": 15 * time.Minute,
+	"30m": 30 * time.Minute,
+	"1h":  time.Hour,
+	"2h":  2 * time.Hour,
+	"4h":  4 * time.Hour,
+	"8h":  8 * time.Hour,
+	"12h": 12 * time.Hour,
+	"1d":  24 * time.Hour,
+}
+
+type klinesResponse struct {
+	Success bool        `json:"success"`
+	Data    []Candle    `json:"data"`
+	Error   interface{} `json:"error"`
+	Code    interface{} `json:"code"`
+}
+
+// GetKlines returns the candles of a symbol between start and end, a zero end means now.
+// Ranges longer than a single response are fetched page by page.
+func (c *RESTClient) GetKlines(ctx context.Context, symbol, interval string, start, end time.Time) ([]Candle, error) {
+	if symbol == "" {
+		return nil, fmt.Errorf("klines: symbol is required")
+	}
+	if !slices.Contains(validIntervals, interval) {
+		return nil, fmt.Errorf("klines: invalid interval: %s", interval)
+	}
+	if end.IsZero() {
+		end = time.Now()
+	}
+	if !start.Before(end) {
+		return nil, fmt.Errorf("klines: start must be before end")
+	}
+
+	step := intervalDurations[interval].Milliseconds()
+	cursor := start.UnixMilli()
+	endMs := end.UnixMilli()
+
+	var candles []Candle
+	for cursor < endMs {
+		page, err := c.getKlinesPage(ctx, symbol, interval, cursor, endMs)
+		if err != nil {
+			return nil, err
+		}
+
+		// Pages are not guaranteed to be in ascending order
+		slices.SortFunc(page, func(a, b Candle) int {
+			return cmp.Compare(a.StartTime, b.StartTime)
+		})
+
+		next := cursor
+		for _, candle := range page {
+			if candle.StartTime < cursor || candle.StartTime >= endMs {
+				continue
+			}
+			candles = append(candles, candle)
+			next = max(next, candle.StartTime+step)
+		}
+		if next == cursor {
+			break
+		}
+		cursor = next
+	}
+
+	return candles, nil
+}
+
+func (c *RESTClient) getKlinesPage(ctx context.Context, symbol, interval string, start, end int64) ([]Candle, error) {
+	query := url.Values{}
+	query.Set("symbol", symbol)
+	query.Set("interval", interval)
+	query.Set("start_time", strconv.FormatInt(start, 10))
+	query.Set("end_time", strconv.FormatInt(end, 10))
+
+	klinesResp, err := doGet[klinesResponse](ctx, c, "/kline", query)
+	if err != nil {
+		return nil, fmt.Errorf("klines: %w", err)
+	}
+	return klinesResp.Data, nil
+}
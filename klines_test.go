@@ -0,0 +1,82 @@
+package pacifica
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRESTClient_GetKlines(t *testing.T) {
+	const (
+		pageSize = 3
+		step     = int64(time.Minute / time.Millisecond)
+	)
+	start := time.UnixMilli(1748954160000)
+	end := start.Add(7 * time.Minute)
+
+	for _, descending := range []bool{false, true} {
+		t.Run("descending="+strconv.FormatBool(descending), func(t *testing.T) {
+			var requests int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				assert.Equal(t, "/kline", r.URL.Path)
+				assert.Equal(t, "BTC", r.URL.Query().Get("symbol"))
+				assert.Equal(t, "1m", r.URL.Query().Get("interval"))
+
+				from, err := strconv.ParseInt(r.URL.Query().Get("start_time"), 10, 64)
+				require.NoError(t, err)
+				to, err := strconv.ParseInt(r.URL.Query().Get("end_time"), 10, 64)
+				require.NoError(t, err)
+
+				// Serve at most pageSize candles per request
+				var candles []Candle
+				for ts := from; ts < to && len(candles) < pageSize; ts += step {
+					candles = append(candles, Candle{StartTime: ts, EndTime: ts + step, Symbol: "BTC", Interval: "1m"})
+				}
+				if descending {
+					slices.Reverse(candles)
+				}
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"success": true, "data": candles})
+			}))
+			defer server.Close()
+
+			client := NewRESTClient(server.URL, nil)
+
+			candles, err := client.GetKlines(context.Background(), "BTC", "1m", start, end)
+			require.NoError(t, err)
+			require.Len(t, candles, 7)
+			assert.Equal(t, 3, requests)
+			for i, candle := range candles {
+				assert.Equal(t, start.UnixMilli()+int64(i)*step, candle.StartTime)
+			}
+		})
+	}
+}
+
+func TestRESTClient_GetKlinesValidation(t *testing.T) {
+	client := NewRESTClient("http://127.0.0.1:0", nil)
+	start := time.UnixMilli(1748954160000)
+
+	_, err := client.GetKlines(context.Background(), "", "1m", start, start.Add(time.Hour))
+	assert.Error(t, err)
+
+	_, err = client.GetKlines(context.Background(), "BTC", "2m", start, start.Add(time.Hour))
+	assert.Error(t, err)
+
+	_, err = client.GetKlines(context.Background(), "BTC", "1m", start, start)
+	assert.Error(t, err)
+}
+
+func TestIntervalDurations(t *testing.T) {
+	for _, interval := range validIntervals {
+		assert.Contains(t, intervalDurations, interval)
+	}
+}
@@ -0,0 +1,239 @@
+package pacifica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ValidateLeverage checks that the leverage is allowed for the symbol
+func (i SymbolInfo) ValidateLeverage(leverage int) error {
+	if leverage < 1 {
+		return fmt.Errorf("leverage must be at least 1")
+	}
+	if i.MaxLeverage > 0 && leverage > i.MaxLeverage {
+		return fmt.Errorf("leverage %d exceeds max leverage %d for %s", leverage, i.MaxLeverage, i.Symbol)
+	}
+	return nil
+}
+
+// ValidateMarginMode checks that the margin mode is allowed for the symbol
+func (i SymbolInfo) ValidateMarginMode(isolated bool) error {
+	if i.IsolatedOnly && !isolated {
+		return fmt.Errorf("%s supports isolated margin only", i.Symbol)
+	}
+	return nil
+}
+
+// getSymbolInfo looks up the market info of a single symbol
+func (c *RESTClient) getSymbolInfo(ctx context.Context, symbol string) (*SymbolInfo, error) {
+	marketInfo, err := c.GetMarketInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range marketInfo {
+		if info.Symbol == symbol {
+			return &info, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown symbol: %s", symbol)
+}
+
+// UpdateLeverageRequest represents the request data for updating the leverage of a market
+type UpdateLeverageRequest struct {
+	Symbol   string `json:"symbol"`
+	Leverage int    `json:"leverage"`
+}
+
+func (r UpdateLeverageRequest) String() string {
+	data, _ := json.Marshal(r)
+	return string(data)
+}
+
+// UpdateLeverageOptions contains optional parameters for updating leverage.
+// When SymbolInfo is set the leverage is validated against it.
+type UpdateLeverageOptions struct {
+	AgentWallet  *string
+	ExpiryWindow int64
+	SymbolInfo   *SymbolInfo
+}
+
+// BuildUpdateLeverageRequest builds a signed request for updating the leverage of a market
+func (s *Exchange) BuildUpdateLeverageRequest(params UpdateLeverageRequest, opts *UpdateLeverageOptions) (map[string]interface{}, error) {
+	return buildUpdateLeverageRequest(context.Background(), s, params, opts)
+}
+
+// buildUpdateLeverageRequest is BuildUpdateLeverageRequest passing ctx to the signer
+func buildUpdateLeverageRequest(ctx context.Context, s *Exchange, params UpdateLeverageRequest, opts *UpdateLeverageOptions) (map[string]interface{}, error) {
+	// Validate required fields
+	if params.Symbol == "" {
+		return nil, fmt.Errorf("symbol is required")
+	}
+	if params.Leverage < 1 {
+		return nil, fmt.Errorf("leverage must be at least 1")
+	}
+	if opts != nil && opts.SymbolInfo != nil {
+		if opts.SymbolInfo.Symbol != params.Symbol {
+			return nil, fmt.Errorf("symbol info is for %s, not %s", opts.SymbolInfo.Symbol, params.Symbol)
+		}
+		if err := opts.SymbolInfo.ValidateLeverage(params.Leverage); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal params: %w", err)
+	}
+
+	var operationData map[string]interface{}
+	if err := json.Unmarshal(data, &operationData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal params: %w", err)
+	}
+
+	// Determine expiry window
+	expiryWindow := int64(0)
+	if opts != nil && opts.ExpiryWindow != 0 {
+		expiryWindow = opts.ExpiryWindow
+	}
+
+	// Build signed request with operation type "update_leverage"
+	request, err := s.BuildSignedRequestWithContext(ctx, "update_leverage", operationData, expiryWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build signed request: %w", err)
+	}
+
+	// Add agent_wallet if provided
+	if opts != nil && opts.AgentWallet != nil {
+		request["agent_wallet"] = *opts.AgentWallet
+	}
+
+	return request, nil
+}
+
+// UpdateLeverageResponse represents the response from the update leverage endpoint
+type UpdateLeverageResponse struct {
+	Success bool `json:"success"`
+}
+
+// UpdateLeverage updates the leverage of a market on Pacifica.
+// When opts.SymbolInfo is not set the market info is fetched to validate the leverage.
+func (c *RESTClient) UpdateLeverage(params UpdateLeverageRequest, opts *UpdateLeverageOptions) (*UpdateLeverageResponse, error) {
+	return c.UpdateLeverageWithContext(context.Background(), params, opts)
+}
+
+// UpdateLeverageWithContext is like UpdateLeverage but aborts the HTTP request when ctx is done
+func (c *RESTClient) UpdateLeverageWithContext(ctx context.Context, params UpdateLeverageRequest, opts *UpdateLeverageOptions) (*UpdateLeverageResponse, error) {
+	if opts == nil || opts.SymbolInfo == nil {
+		info, err := c.getSymbolInfo(ctx, params.Symbol)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get symbol info: %w", err)
+		}
+		withInfo := UpdateLeverageOptions{SymbolInfo: info}
+		if opts != nil {
+			withInfo.AgentWallet = opts.AgentWallet
+			withInfo.ExpiryWindow = opts.ExpiryWindow
+		}
+		opts = &withInfo
+	}
+
+	return doSigned[UpdateLeverageRequest, UpdateLeverageResponse](ctx, c, "/account/leverage", buildUpdateLeverageRequest, params, opts)
+}
+
+// UpdateMarginModeRequest represents the request data for updating the margin mode of a market
+type UpdateMarginModeRequest struct {
+	Symbol     string `json:"symbol"`
+	IsIsolated bool   `json:"is_isolated"`
+}
+
+func (r UpdateMarginModeRequest) String() string {
+	data, _ := json.Marshal(r)
+	return string(data)
+}
+
+// UpdateMarginModeOptions contains optional parameters for updating margin mode.
+// When SymbolInfo is set the margin mode is validated against it.
+type UpdateMarginModeOptions struct {
+	AgentWallet  *string
+	ExpiryWindow int64
+	SymbolInfo   *SymbolInfo
+}
+
+// BuildUpdateMarginModeRequest builds a signed request for updating the margin mode of a market
+func (s *Exchange) BuildUpdateMarginModeRequest(params UpdateMarginModeRequest, opts *UpdateMarginModeOptions) (map[string]interface{}, error) {
+	return buildUpdateMarginModeRequest(context.Background(), s, params, opts)
+}
+
+// buildUpdateMarginModeRequest is BuildUpdateMarginModeRequest passing ctx to the signer
+func buildUpdateMarginModeRequest(ctx context.Context, s *Exchange, params UpdateMarginModeRequest, opts *UpdateMarginModeOptions) (map[string]interface{}, error) {
+	// Validate required fields
+	if params.Symbol == "" {
+		return nil, fmt.Errorf("symbol is required")
+	}
+	if opts != nil && opts.SymbolInfo != nil {
+		if opts.SymbolInfo.Symbol != params.Symbol {
+			return nil, fmt.Errorf("symbol info is for %s, not %s", opts.SymbolInfo.Symbol, params.Symbol)
+		}
+		if err := opts.SymbolInfo.ValidateMarginMode(params.IsIsolated); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal params: %w", err)
+	}
+
+	var operationData map[string]interface{}
+	if err := json.Unmarshal(data, &operationData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal params: %w", err)
+	}
+
+	// Determine expiry window
+	expiryWindow := int64(0)
+	if opts != nil && opts.ExpiryWindow != 0 {
+		expiryWindow = opts.ExpiryWindow
+	}
+
+	// Build signed request with operation type "update_margin_mode"
+	request, err := s.BuildSignedRequestWithContext(ctx, "update_margin_mode", operationData, expiryWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build signed request: %w", err)
+	}
+
+	// Add agent_wallet if provided
+	if opts != nil && opts.AgentWallet != nil {
+		request["agent_wallet"] = *opts.AgentWallet
+	}
+
+	return request, nil
+}
+
+// UpdateMarginModeResponse represents the response from the update margin mode endpoint
+type UpdateMarginModeResponse struct {
+	Success bool `json:"success"`
+}
+
+// UpdateMarginMode switches a market between cross and isolated margin on Pacifica.
+// When opts.SymbolInfo is not set the market info is fetched to validate the margin mode.
+func (c *RESTClient) UpdateMarginMode(params UpdateMarginModeRequest, opts *UpdateMarginModeOptions) (*UpdateMarginModeResponse, error) {
+	return c.UpdateMarginModeWithContext(context.Background(), params, opts)
+}
+
+// UpdateMarginModeWithContext is like UpdateMarginMode but aborts the HTTP request when ctx is done
+func (c *RESTClient) UpdateMarginModeWithContext(ctx context.Context, params UpdateMarginModeRequest, opts *UpdateMarginModeOptions) (*UpdateMarginModeResponse, error) {
+	if opts == nil || opts.SymbolInfo == nil {
+		info, err := c.getSymbolInfo(ctx, params.Symbol)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get symbol info: %w", err)
+		}
+		withInfo := UpdateMarginModeOptions{SymbolInfo: info}
+		if opts != nil {
+			withInfo.AgentWallet = opts.AgentWallet
+			withInfo.ExpiryWindow = opts.ExpiryWindow
+		}
+		opts = &withInfo
+	}
+
+	return doSigned[UpdateMarginModeRequest, UpdateMarginModeResponse](ctx, c, "/account/margin", buildUpdateMarginModeRequest, params, opts)
+}
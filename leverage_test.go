@@ -0,0 +1,169 @@
+package pacifica
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildUpdateLeverageRequest(t *testing.T) {
+	signer := generateTestExchange(t)
+	btcInfo := &SymbolInfo{Symbol: "BTC", MaxLeverage: 50}
+
+	tests := []struct {
+		name     string
+		params   UpdateLeverageRequest
+		opts     *UpdateLeverageOptions
+		wantErr  bool
+		validate func(*testing.T, map[string]interface{})
+	}{
+		{
+			name:   "update leverage",
+			params: UpdateLeverageRequest{Symbol: "BTC", Leverage: 10},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				assert.Equal(t, "BTC", req["symbol"])
+				assert.Equal(t, float64(10), req["leverage"])
+				assert.Contains(t, req, "account")
+				assert.Contains(t, req, "signature")
+				assert.Contains(t, req, "timestamp")
+			},
+		},
+		{
+			name:   "update leverage within max leverage",
+			params: UpdateLeverageRequest{Symbol: "BTC", Leverage: 50},
+			opts:   &UpdateLeverageOptions{SymbolInfo: btcInfo},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				assert.Equal(t, float64(50), req["leverage"])
+			},
+		},
+		{
+			name:    "leverage above max leverage",
+			params:  UpdateLeverageRequest{Symbol: "BTC", Leverage: 51},
+			opts:    &UpdateLeverageOptions{SymbolInfo: btcInfo},
+			wantErr: true,
+		},
+		{
+			name:    "symbol info for another symbol",
+			params:  UpdateLeverageRequest{Symbol: "ETH", Leverage: 5},
+			opts:    &UpdateLeverageOptions{SymbolInfo: btcInfo},
+			wantErr: true,
+		},
+		{
+			name:    "missing symbol",
+			params:  UpdateLeverageRequest{Leverage: 10},
+			wantErr: true,
+		},
+		{
+			name:    "zero leverage",
+			params:  UpdateLeverageRequest{Symbol: "BTC"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := signer.BuildUpdateLeverageRequest(tt.params, tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.validate != nil {
+				tt.validate(t, req)
+			}
+		})
+	}
+}
+
+func TestBuildUpdateMarginModeRequest(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	tests := []struct {
+		name     string
+		params   UpdateMarginModeRequest
+		opts     *UpdateMarginModeOptions
+		wantErr  bool
+		validate func(*testing.T, map[string]interface{})
+	}{
+		{
+			name:   "switch to isolated",
+			params: UpdateMarginModeRequest{Symbol: "BTC", IsIsolated: true},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				assert.Equal(t, "BTC", req["symbol"])
+				assert.Equal(t, true, req["is_isolated"])
+			},
+		},
+		{
+			name:   "switch to cross",
+			params: UpdateMarginModeRequest{Symbol: "BTC"},
+			opts:   &UpdateMarginModeOptions{SymbolInfo: &SymbolInfo{Symbol: "BTC"}},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				assert.Equal(t, false, req["is_isolated"])
+			},
+		},
+		{
+			name:    "cross on isolated only symbol",
+			params:  UpdateMarginModeRequest{Symbol: "BTC"},
+			opts:    &UpdateMarginModeOptions{SymbolInfo: &SymbolInfo{Symbol: "BTC", IsolatedOnly: true}},
+			wantErr: true,
+		},
+		{
+			name:    "missing symbol",
+			params:  UpdateMarginModeRequest{IsIsolated: true},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := signer.BuildUpdateMarginModeRequest(tt.params, tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.validate != nil {
+				tt.validate(t, req)
+			}
+		})
+	}
+}
+
+func TestRESTClientUpdateLeverageFetchesSymbolInfo(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	var leverageCalls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/info":
+			_, _ = w.Write([]byte(`{"success":true,"data":[{"symbol":"BTC","max_leverage":20}],"error":null,"code":null}`))
+		case "/account/leverage":
+			leverageCalls++
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, float64(10), body["leverage"])
+			_, _ = w.Write([]byte(`{"success":true}`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, signer)
+
+	response, err := client.UpdateLeverage(UpdateLeverageRequest{Symbol: "BTC", Leverage: 10}, nil)
+	require.NoError(t, err)
+	assert.True(t, response.Success)
+
+	_, err = client.UpdateLeverage(UpdateLeverageRequest{Symbol: "BTC", Leverage: 25}, nil)
+	assert.Error(t, err)
+
+	_, err = client.UpdateLeverage(UpdateLeverageRequest{Symbol: "DOGE", Leverage: 2}, nil)
+	assert.Error(t, err)
+
+	assert.Equal(t, 1, leverageCalls)
+}
@@ -1,11 +1,9 @@
 package pacifica
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 )
 
 // OrderSide represents the order side (bid or ask)
@@ -60,6 +58,11 @@ type CreateLimitOrderOptions struct {
 
 // BuildCreateLimitOrderRequest builds a signed request for creating a limit order
 func (s *Exchange) BuildCreateLimitOrderRequest(params CreateLimitOrderRequest, opts *CreateLimitOrderOptions) (map[string]interface{}, error) {
+	return buildCreateLimitOrderRequest(context.Background(), s, params, opts)
+}
+
+// buildCreateLimitOrderRequest is BuildCreateLimitOrderRequest passing ctx to the signer
+func buildCreateLimitOrderRequest(ctx context.Context, s *Exchange, params CreateLimitOrderRequest, opts *CreateLimitOrderOptions) (map[string]interface{}, error) {
 	// Validate required fields
 	if params.Symbol == "" {
 		return nil, fmt.Errorf("symbol is required")
@@ -94,7 +97,7 @@ func (s *Exchange) BuildCreateLimitOrderRequest(params CreateLimitOrderRequest,
 	}
 
 	// Build signed request
-	request, err := s.BuildSignedRequest("create_order", operationData, expiryWindow)
+	request, err := s.BuildSignedRequestWithContext(ctx, "create_order", operationData, expiryWindow)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build signed request: %w", err)
 	}
@@ -112,7 +115,9 @@ type CreateLimitOrderResponse struct {
 	OrderID int64 `json:"order_id"`
 }
 
-// CreateLimitOrderError represents an error response from the API
+// CreateLimitOrderError represents an error response from the API.
+//
+// Deprecated: API errors are returned as *APIError.
 type CreateLimitOrderError struct {
 	Error string `json:"error"`
 	Code  int    `json:"code"`
@@ -120,58 +125,26 @@ type CreateLimitOrderError struct {
 
 // CreateLimitOrder creates a limit order on Pacifica
 func (c *RESTClient) CreateLimitOrder(params CreateLimitOrderRequest, opts *CreateLimitOrderOptions) (*CreateLimitOrderResponse, error) {
-	// Build signed request
-	request, err := c.signer.BuildCreateLimitOrderRequest(params, opts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to build signed request: %w", err)
-	}
-
-	// Marshal request to JSON
-	jsonData, err := json.Marshal(request)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	// Create HTTP request
-	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/orders/create", bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	// Make the request
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make HTTP request: %w", err)
-	}
-	defer resp.Body.Close()
+	return c.CreateLimitOrderWithContext(context.Background(), params, opts)
+}
 
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+// CreateLimitOrderWithContext is like CreateLimitOrder but aborts the HTTP request when ctx is done.
+// With a retry policy the order is given a client order ID when it has none.
+func (c *RESTClient) CreateLimitOrderWithContext(ctx context.Context, params CreateLimitOrderRequest, opts *CreateLimitOrderOptions) (*CreateLimitOrderResponse, error) {
+	// A client order ID lets a retry find out whether the order was created
+	if c.retryPolicy != nil && params.ClientOrderID == "" {
+		params.ClientOrderID = newClientOrderID()
 	}
 
-	// Handle different status codes
-	switch resp.StatusCode {
-	case http.StatusOK:
-		var response CreateLimitOrderResponse
-		if err := json.Unmarshal(body, &response); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-		}
-		return &response, nil
-	case http.StatusBadRequest:
-		var apiError CreateLimitOrderError
-		if err := json.Unmarshal(body, &apiError); err != nil {
-			return nil, fmt.Errorf("bad request: %s", string(body))
+	orderID, err := c.createOrder(ctx, params.ClientOrderID, func() (int64, error) {
+		response, err := doSigned[CreateLimitOrderRequest, CreateLimitOrderResponse](ctx, c, "/orders/create", buildCreateLimitOrderRequest, params, opts)
+		if err != nil {
+			return 0, err
 		}
-		return nil, fmt.Errorf("API error (code %d): %s", apiError.Code, apiError.Error)
-	default:
-		var apiError CreateLimitOrderError
-		if err := json.Unmarshal(body, &apiError); err == nil {
-			return nil, fmt.Errorf("API error (code %d): %s", apiError.Code, apiError.Error)
-		}
-		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
+		return response.OrderID, nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return &CreateLimitOrderResponse{OrderID: orderID}, nil
 }
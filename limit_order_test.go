@@ -1,7 +1,12 @@
 package pacifica
 
 import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -346,6 +351,43 @@ func TestRESTClient(t *testing.T) {
 	assert.Equal(t, customURL, client2.baseURL)
 }
 
+func TestCreateLimitOrderWithContext(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/orders/create", r.URL.Path)
+		_, _ = w.Write([]byte(`{"order_id":12345}`))
+	}))
+	defer server.Close()
+
+	params := CreateLimitOrderRequest{
+		Symbol: "BTC",
+		Price:  "50000",
+		Amount: "0.1",
+		Side:   SideBid,
+		TIF:    TIFGTC,
+	}
+
+	client := NewRESTClient(server.URL, signer)
+	response, err := client.CreateLimitOrderWithContext(context.Background(), params, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(12345), response.OrderID)
+
+	// The deadline aborts the in-flight request
+	slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		<-r.Context().Done()
+	}))
+	defer slowServer.Close()
+
+	slowClient := NewRESTClient(slowServer.URL, signer)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = slowClient.CreateLimitOrderWithContext(ctx, params, nil)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
 func TestOrderConstants(t *testing.T) {
 	// Test OrderSide constants
 	assert.Equal(t, OrderSide("bid"), SideBid)
@@ -0,0 +1,79 @@
+package pacifica
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+type orderBookResponse struct {
+	Success bool        `json:"success"`
+	Data    OrderBook   `json:"data"`
+	Error   interface{} `json:"error"`
+	Code    interface{} `json:"code"`
+}
+
+// GetOrderBook returns an order book snapshot, a zero aggLevel uses the exchange default
+func (c *RESTClient) GetOrderBook(ctx context.Context, symbol string, aggLevel int) (*OrderBook, error) {
+	if symbol == "" {
+		return nil, fmt.Errorf("book: symbol is required")
+	}
+
+	query := url.Values{}
+	query.Set("symbol", symbol)
+	if aggLevel > 0 {
+		query.Set("agg_level", strconv.Itoa(aggLevel))
+	}
+
+	orderBookResp, err := doGet[orderBookResponse](ctx, c, "/book", query)
+	if err != nil {
+		return nil, fmt.Errorf("book: %w", err)
+	}
+	return &orderBookResp.Data, nil
+}
+
+// restTrade is the REST representation of a public trade
+type restTrade struct {
+	EventType string `json:"event_type"`
+	Price     string `json:"price"`
+	Amount    string `json:"amount"`
+	Side      string `json:"side"`
+	Cause     string `json:"cause"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+type recentTradesResponse struct {
+	Success bool        `json:"success"`
+	Data    []restTrade `json:"data"`
+	Error   interface{} `json:"error"`
+	Code    interface{} `json:"code"`
+}
+
+// GetRecentTrades returns the most recent public trades of a symbol
+func (c *RESTClient) GetRecentTrades(ctx context.Context, symbol string) (Trades, error) {
+	if symbol == "" {
+		return nil, fmt.Errorf("trades: symbol is required")
+	}
+
+	query := url.Values{}
+	query.Set("symbol", symbol)
+
+	recentTradesResp, err := doGet[recentTradesResponse](ctx, c, "/trades", query)
+	if err != nil {
+		return nil, fmt.Errorf("trades: %w", err)
+	}
+
+	trades := make(Trades, 0, len(recentTradesResp.Data))
+	for _, t := range recentTradesResp.Data {
+		trades = append(trades, Trade{
+			Amount:     t.Amount,
+			TradeSide:  t.Side,
+			Price:      t.Price,
+			Symbol:     symbol,
+			Timestamp:  t.CreatedAt,
+			TradeCause: t.Cause,
+		})
+	}
+	return trades, nil
+}
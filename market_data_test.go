@@ -0,0 +1,58 @@
+package pacifica
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRESTClient_GetOrderBook(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/book", r.URL.Path)
+		assert.Equal(t, "BTC", r.URL.Query().Get("symbol"))
+		assert.Equal(t, "10", r.URL.Query().Get("agg_level"))
+		_, _ = w.Write([]byte(`{"success":true,"data":{"s":"BTC","l":[[{"p":"106504","a":"0.26203","n":1}],` +
+			`[{"p":"106559","a":"0.26802","n":1}]],"t":1751370536325},"error":null,"code":null}`))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, nil)
+
+	book, err := client.GetOrderBook(context.Background(), "BTC", 10)
+	require.NoError(t, err)
+	assert.Equal(t, "BTC", book.Coin)
+	assert.Equal(t, int64(1751370536325), book.Time)
+	require.Len(t, book.Levels, 2)
+	assert.Equal(t, Level{Quantity: "0.26203", Price: "106504", Orders: 1}, book.Levels[0][0])
+	assert.Equal(t, Level{Quantity: "0.26802", Price: "106559", Orders: 1}, book.Levels[1][0])
+	assert.Equal(t, keyOrderBook("BTC"), book.Key())
+}
+
+func TestRESTClient_GetRecentTrades(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/trades", r.URL.Path)
+		assert.Equal(t, "ETH", r.URL.Query().Get("symbol"))
+		_, _ = w.Write([]byte(`{"success":true,"data":[{"event_type":"fulfill_taker","price":"104721",` +
+			`"amount":"0.0001","side":"close_long","cause":"normal","created_at":1765006315306}],"error":null,"code":null}`))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, nil)
+
+	trades, err := client.GetRecentTrades(context.Background(), "ETH")
+	require.NoError(t, err)
+	require.Len(t, trades, 1)
+	assert.Equal(t, Trade{
+		Amount:     "0.0001",
+		TradeSide:  "close_long",
+		Price:      "104721",
+		Symbol:     "ETH",
+		Timestamp:  1765006315306,
+		TradeCause: "normal",
+	}, trades[0])
+	assert.Equal(t, keyTrades("ETH"), trades.Key())
+}
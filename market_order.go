@@ -1,11 +1,9 @@
 package pacifica
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 )
 
 // CreateMarketOrderRequest represents the request data for creating a market order
@@ -35,6 +33,11 @@ type CreateMarketOrderOptions struct {
 
 // BuildCreateMarketOrderRequest builds a signed request for creating a market order
 func (s *Exchange) BuildCreateMarketOrderRequest(params CreateMarketOrderRequest, opts *CreateMarketOrderOptions) (map[string]interface{}, error) {
+	return buildCreateMarketOrderRequest(context.Background(), s, params, opts)
+}
+
+// buildCreateMarketOrderRequest is BuildCreateMarketOrderRequest passing ctx to the signer
+func buildCreateMarketOrderRequest(ctx context.Context, s *Exchange, params CreateMarketOrderRequest, opts *CreateMarketOrderOptions) (map[string]interface{}, error) {
 	// Validate required fields
 	if params.Symbol == "" {
 		return nil, fmt.Errorf("symbol is required")
@@ -66,7 +69,7 @@ func (s *Exchange) BuildCreateMarketOrderRequest(params CreateMarketOrderRequest
 	}
 
 	// Build signed request with operation type "create_market_order"
-	request, err := s.BuildSignedRequest("create_market_order", operationData, expiryWindow)
+	request, err := s.BuildSignedRequestWithContext(ctx, "create_market_order", operationData, expiryWindow)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build signed request: %w", err)
 	}
@@ -84,7 +87,9 @@ type CreateMarketOrderResponse struct {
 	OrderID int64 `json:"order_id"`
 }
 
-// CreateMarketOrderError represents an error response from the API
+// CreateMarketOrderError represents an error response from the API.
+//
+// Deprecated: API errors are returned as *APIError.
 type CreateMarketOrderError struct {
 	Error string `json:"error"`
 	Code  int    `json:"code"`
@@ -92,58 +97,26 @@ type CreateMarketOrderError struct {
 
 // CreateMarketOrder creates a market order on Pacifica
 func (c *RESTClient) CreateMarketOrder(params CreateMarketOrderRequest, opts *CreateMarketOrderOptions) (*CreateMarketOrderResponse, error) {
-	// Build signed request
-	request, err := c.signer.BuildCreateMarketOrderRequest(params, opts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to build signed request: %w", err)
-	}
-
-	// Marshal request to JSON
-	jsonData, err := json.Marshal(request)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	// Create HTTP request
-	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/orders/create_market", bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create HTTP request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	// Make the request
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to make HTTP request: %w", err)
-	}
-	defer resp.Body.Close()
+	return c.CreateMarketOrderWithContext(context.Background(), params, opts)
+}
 
-	// Read response body
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
+// CreateMarketOrderWithContext is like CreateMarketOrder but aborts the HTTP request when ctx is done.
+// With a retry policy the order is given a client order ID when it has none.
+func (c *RESTClient) CreateMarketOrderWithContext(ctx context.Context, params CreateMarketOrderRequest, opts *CreateMarketOrderOptions) (*CreateMarketOrderResponse, error) {
+	// A client order ID lets a retry find out whether the order was created
+	if c.retryPolicy != nil && params.ClientOrderID == "" {
+		params.ClientOrderID = newClientOrderID()
 	}
 
-	// Handle different status codes
-	switch resp.StatusCode {
-	case http.StatusOK:
-		var response CreateMarketOrderResponse
-		if err := json.Unmarshal(body, &response); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
-		}
-		return &response, nil
-	case http.StatusBadRequest:
-		var apiError CreateMarketOrderError
-		if err := json.Unmarshal(body, &apiError); err != nil {
-			return nil, fmt.Errorf("bad request: %s", string(body))
+	orderID, err := c.createOrder(ctx, params.ClientOrderID, func() (int64, error) {
+		response, err := doSigned[CreateMarketOrderRequest, CreateMarketOrderResponse](ctx, c, "/orders/create_market", buildCreateMarketOrderRequest, params, opts)
+		if err != nil {
+			return 0, err
 		}
-		return nil, fmt.Errorf("API error (code %d): %s", apiError.Code, apiError.Error)
-	default:
-		var apiError CreateMarketOrderError
-		if err := json.Unmarshal(body, &apiError); err == nil {
-			return nil, fmt.Errorf("API error (code %d): %s", apiError.Code, apiError.Error)
-		}
-		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, string(body))
+		return response.OrderID, nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return &CreateMarketOrderResponse{OrderID: orderID}, nil
 }
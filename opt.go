@@ -1,5 +1,7 @@
 package pacifica
 
+import "net/http"
+
 type Opt[T any] func(opt *T)
 
 func (o Opt[T]) Apply(opt *T) {
@@ -7,7 +9,8 @@ func (o Opt[T]) Apply(opt *T) {
 }
 
 type (
-	WsOpt = Opt[WebsocketClient]
+	WsOpt   = Opt[WebsocketClient]
+	RESTOpt = Opt[RESTClient]
 )
 
 func WithOptDebugMode(l logger) WsOpt {
@@ -16,3 +19,56 @@ func WithOptDebugMode(l logger) WsOpt {
 		w.logger = l
 	}
 }
+
+// WithRateLimiter limits the requests of the REST client with the token bucket l
+func WithRateLimiter(l *RateLimiter) RESTOpt {
+	return func(c *RESTClient) {
+		c.rateLimiter = l
+	}
+}
+
+// WithRetryPolicy retries requests failing with a network error or a 5xx response with exponential backoff.
+// Zero fields of p take their default values.
+func WithRetryPolicy(p RetryPolicy) RESTOpt {
+	return func(c *RESTClient) {
+		p = p.withDefaults()
+		c.retryPolicy = &p
+	}
+}
+
+// WithHTTPClient replaces the default HTTP client with a 30s timeout, e.g. to use a custom transport or proxy
+func WithHTTPClient(httpClient *http.Client) RESTOpt {
+	return func(c *RESTClient) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithLogger logs the retries and rate limiting of the REST client
+func WithLogger(l logger) RESTOpt {
+	return func(c *RESTClient) {
+		c.logger = l
+	}
+}
+
+// WithDefaultExpiryWindow sets the expiry window in milliseconds of requests signed without one.
+// Non-positive windows are ignored, as is the option on a client without a signer.
+func WithDefaultExpiryWindow(expiryWindow int64) RESTOpt {
+	return func(c *RESTClient) {
+		if c.signer == nil || expiryWindow <= 0 {
+			return
+		}
+		// Copy the signer so that other clients sharing it keep their expiry window
+		signer := *c.signer
+		signer.expiryWindow = expiryWindow
+		c.signer = &signer
+	}
+}
+
+// WithUserAgent sets the User-Agent header of the REST requests
+func WithUserAgent(userAgent string) RESTOpt {
+	return func(c *RESTClient) {
+		c.userAgent = userAgent
+	}
+}
@@ -0,0 +1,108 @@
+package pacifica
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// OrderStatus represents the status of an order
+type OrderStatus string
+
+const (
+	OrderStatusOpen            OrderStatus = "open"
+	OrderStatusPartiallyFilled OrderStatus = "partially_filled"
+	OrderStatusFilled          OrderStatus = "filled"
+	OrderStatusCancelled       OrderStatus = "cancelled"
+	OrderStatusRejected        OrderStatus = "rejected"
+)
+
+// Order represents an order of an account
+type Order struct {
+	OrderID            int64       `json:"order_id"`
+	ClientOrderID      string      `json:"client_order_id"`
+	Symbol             string      `json:"symbol"`
+	Side               OrderSide   `json:"side"`
+	Price              string      `json:"price"`
+	InitialAmount      string      `json:"initial_amount"`
+	FilledAmount       string      `json:"filled_amount"`
+	CancelledAmount    string      `json:"cancelled_amount"`
+	AverageFilledPrice string      `json:"average_filled_price"`
+	StopPrice          string      `json:"stop_price"`
+	OrderType          string      `json:"order_type"`
+	OrderStatus        OrderStatus `json:"order_status"`
+	TIF                TimeInForce `json:"tif"`
+	ReduceOnly         bool        `json:"reduce_only"`
+	CreatedAt          int64       `json:"created_at"`
+	UpdatedAt          int64       `json:"updated_at"`
+}
+
+type openOrdersResponse struct {
+	Success bool        `json:"success"`
+	Data    []Order     `json:"data"`
+	Error   interface{} `json:"error"`
+	Code    interface{} `json:"code"`
+}
+
+func (c *RESTClient) GetOpenOrders(ctx context.Context, account string) ([]Order, error) {
+	if account == "" {
+		return nil, fmt.Errorf("open orders: account is required")
+	}
+
+	query := url.Values{}
+	query.Set("account", account)
+
+	openOrdersResp, err := doGet[openOrdersResponse](ctx, c, "/orders", query)
+	if err != nil {
+		return nil, fmt.Errorf("open orders: %w", err)
+	}
+	return openOrdersResp.Data, nil
+}
+
+// OrderHistoryParams contains the pagination parameters for the order history query
+type OrderHistoryParams struct {
+	Cursor string
+	Limit  int
+}
+
+// OrderHistoryPage represents a single page of the order history
+type OrderHistoryPage struct {
+	Orders     []Order
+	NextCursor string
+	HasMore    bool
+}
+
+type orderHistoryResponse struct {
+	Success    bool        `json:"success"`
+	Data       []Order     `json:"data"`
+	NextCursor string      `json:"next_cursor"`
+	HasMore    bool        `json:"has_more"`
+	Error      interface{} `json:"error"`
+	Code       interface{} `json:"code"`
+}
+
+func (c *RESTClient) GetOrderHistory(ctx context.Context, account string, params OrderHistoryParams) (*OrderHistoryPage, error) {
+	if account == "" {
+		return nil, fmt.Errorf("order history: account is required")
+	}
+
+	query := url.Values{}
+	query.Set("account", account)
+	if params.Cursor != "" {
+		query.Set("cursor", params.Cursor)
+	}
+	if params.Limit > 0 {
+		query.Set("limit", strconv.Itoa(params.Limit))
+	}
+
+	orderHistoryResp, err := doGet[orderHistoryResponse](ctx, c, "/orders/history", query)
+	if err != nil {
+		return nil, fmt.Errorf("order history: %w", err)
+	}
+	return &OrderHistoryPage{
+		Orders:     orderHistoryResp.Data,
+		NextCursor: orderHistoryResp.NextCursor,
+		HasMore:    orderHistoryResp.HasMore,
+	}, nil
+}
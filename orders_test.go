@@ -0,0 +1,65 @@
+package pacifica
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRESTClient_GetOpenOrders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/orders", r.URL.Path)
+		assert.Equal(t, testAccountID, r.URL.Query().Get("account"))
+		_, _ = w.Write([]byte(`{"success":true,"data":[{"order_id":315979358,"client_order_id":"add9a4b5-c7f7-4124-b57f-86982d86d479",` +
+			`"symbol":"ASTER","side":"ask","price":"1.836","initial_amount":"85.33","filled_amount":"0","cancelled_amount":"0",` +
+			`"stop_price":null,"order_type":"limit","order_status":"open","tif":"GTC","reduce_only":false,` +
+			`"created_at":1759224706737,"updated_at":1759224706737}],"error":null,"code":null}`))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, nil)
+
+	orders, err := client.GetOpenOrders(context.Background(), testAccountID)
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	assert.Equal(t, int64(315979358), orders[0].OrderID)
+	assert.Equal(t, "add9a4b5-c7f7-4124-b57f-86982d86d479", orders[0].ClientOrderID)
+	assert.Equal(t, SideAsk, orders[0].Side)
+	assert.Equal(t, OrderStatusOpen, orders[0].OrderStatus)
+	assert.Equal(t, TIFGTC, orders[0].TIF)
+	assert.Equal(t, "", orders[0].StopPrice)
+
+	_, err = client.GetOpenOrders(context.Background(), "")
+	assert.Error(t, err)
+}
+
+func TestRESTClient_GetOrderHistory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/orders/history", r.URL.Path)
+		assert.Equal(t, testAccountID, r.URL.Query().Get("account"))
+		assert.Equal(t, "11115hVka", r.URL.Query().Get("cursor"))
+		assert.Equal(t, "50", r.URL.Query().Get("limit"))
+		_, _ = w.Write([]byte(`{"success":true,"data":[{"order_id":13753364,"symbol":"BTC","side":"bid",` +
+			`"initial_amount":"0.1","filled_amount":"0.1","average_filled_price":"50010","order_status":"filled",` +
+			`"reduce_only":true}],"next_cursor":"11114Lz77","has_more":true,"error":null,"code":null}`))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, nil)
+
+	page, err := client.GetOrderHistory(context.Background(), testAccountID, OrderHistoryParams{
+		Cursor: "11115hVka",
+		Limit:  50,
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Orders, 1)
+	assert.Equal(t, "11114Lz77", page.NextCursor)
+	assert.True(t, page.HasMore)
+	assert.Equal(t, OrderStatusFilled, page.Orders[0].OrderStatus)
+	assert.Equal(t, "50010", page.Orders[0].AverageFilledPrice)
+	assert.True(t, page.Orders[0].ReduceOnly)
+}
@@ -0,0 +1,96 @@
+package pacifica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// SetPositionTPSLRequest represents the request data for setting take profit and stop loss on an open position.
+// Side is the side of the closing orders, e.g. SideAsk for a long position.
+type SetPositionTPSLRequest struct {
+	Symbol     string    `json:"symbol"`
+	Side       OrderSide `json:"side"`
+	TakeProfit *Target   `json:"take_profit,omitempty"`
+	StopLoss   *Target   `json:"stop_loss,omitempty"`
+}
+
+func (r SetPositionTPSLRequest) String() string {
+	data, _ := json.Marshal(r)
+	return string(data)
+}
+
+// SetPositionTPSLOptions contains optional parameters for setting position take profit and stop loss
+type SetPositionTPSLOptions struct {
+	AgentWallet  *string
+	ExpiryWindow int64
+}
+
+// BuildSetPositionTPSLRequest builds a signed request for setting take profit and stop loss on an open position
+func (s *Exchange) BuildSetPositionTPSLRequest(params SetPositionTPSLRequest, opts *SetPositionTPSLOptions) (map[string]interface{}, error) {
+	return buildSetPositionTPSLRequest(context.Background(), s, params, opts)
+}
+
+// buildSetPositionTPSLRequest is BuildSetPositionTPSLRequest passing ctx to the signer
+func buildSetPositionTPSLRequest(ctx context.Context, s *Exchange, params SetPositionTPSLRequest, opts *SetPositionTPSLOptions) (map[string]interface{}, error) {
+	// Validate required fields
+	if params.Symbol == "" {
+		return nil, fmt.Errorf("symbol is required")
+	}
+	if params.Side != SideBid && params.Side != SideAsk {
+		return nil, fmt.Errorf("side must be 'bid' or 'ask'")
+	}
+	if params.TakeProfit == nil && params.StopLoss == nil {
+		return nil, fmt.Errorf("either take_profit or stop_loss is required")
+	}
+	if params.TakeProfit != nil && params.TakeProfit.StopPrice == "" {
+		return nil, fmt.Errorf("take_profit stop_price is required")
+	}
+	if params.StopLoss != nil && params.StopLoss.StopPrice == "" {
+		return nil, fmt.Errorf("stop_loss stop_price is required")
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal params: %w", err)
+	}
+
+	var operationData map[string]interface{}
+	if err := json.Unmarshal(data, &operationData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal params: %w", err)
+	}
+
+	// Determine expiry window
+	expiryWindow := int64(0)
+	if opts != nil && opts.ExpiryWindow != 0 {
+		expiryWindow = opts.ExpiryWindow
+	}
+
+	// Build signed request with operation type "set_position_tpsl"
+	request, err := s.BuildSignedRequestWithContext(ctx, "set_position_tpsl", operationData, expiryWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build signed request: %w", err)
+	}
+
+	// Add agent_wallet if provided
+	if opts != nil && opts.AgentWallet != nil {
+		request["agent_wallet"] = *opts.AgentWallet
+	}
+
+	return request, nil
+}
+
+// SetPositionTPSLResponse represents the response from the set position tpsl endpoint
+type SetPositionTPSLResponse struct {
+	Success bool `json:"success"`
+}
+
+// SetPositionTPSL sets or replaces take profit and stop loss on an open position on Pacifica
+func (c *RESTClient) SetPositionTPSL(params SetPositionTPSLRequest, opts *SetPositionTPSLOptions) (*SetPositionTPSLResponse, error) {
+	return c.SetPositionTPSLWithContext(context.Background(), params, opts)
+}
+
+// SetPositionTPSLWithContext is like SetPositionTPSL but aborts the HTTP request when ctx is done
+func (c *RESTClient) SetPositionTPSLWithContext(ctx context.Context, params SetPositionTPSLRequest, opts *SetPositionTPSLOptions) (*SetPositionTPSLResponse, error) {
+	return doSigned[SetPositionTPSLRequest, SetPositionTPSLResponse](ctx, c, "/positions/tpsl", buildSetPositionTPSLRequest, params, opts)
+}
@@ -0,0 +1,116 @@
+package pacifica
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSetPositionTPSLRequest(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	tests := []struct {
+		name     string
+		params   SetPositionTPSLRequest
+		opts     *SetPositionTPSLOptions
+		wantErr  bool
+		validate func(*testing.T, map[string]interface{})
+	}{
+		{
+			name: "take profit and stop loss",
+			params: SetPositionTPSLRequest{
+				Symbol: "BTC",
+				Side:   SideAsk,
+				TakeProfit: &Target{
+					StopPrice:     "55000",
+					LimitPrice:    "54950",
+					ClientOrderID: "e36ac10b-58cc-4372-a567-0e02b2c3d479",
+				},
+				StopLoss: &Target{
+					StopPrice: "48000",
+				},
+			},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				assert.Equal(t, "BTC", req["symbol"])
+				assert.Equal(t, "ask", req["side"])
+				takeProfit, ok := req["take_profit"].(map[string]interface{})
+				require.True(t, ok)
+				assert.Equal(t, "55000", takeProfit["stop_price"])
+				assert.Equal(t, "54950", takeProfit["limit_price"])
+				assert.Equal(t, "e36ac10b-58cc-4372-a567-0e02b2c3d479", takeProfit["client_order_id"])
+				stopLoss, ok := req["stop_loss"].(map[string]interface{})
+				require.True(t, ok)
+				assert.Equal(t, "48000", stopLoss["stop_price"])
+				assert.NotContains(t, stopLoss, "limit_price")
+				assert.Contains(t, req, "account")
+				assert.Contains(t, req, "signature")
+				assert.Contains(t, req, "timestamp")
+			},
+		},
+		{
+			name: "stop loss only",
+			params: SetPositionTPSLRequest{
+				Symbol:   "ETH",
+				Side:     SideBid,
+				StopLoss: &Target{StopPrice: "2200"},
+			},
+			opts: &SetPositionTPSLOptions{
+				ExpiryWindow: 10000,
+			},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				assert.NotContains(t, req, "take_profit")
+				assert.Contains(t, req, "stop_loss")
+				assert.Equal(t, int64(10000), req["expiry_window"])
+			},
+		},
+		{
+			name: "missing symbol",
+			params: SetPositionTPSLRequest{
+				Side:     SideAsk,
+				StopLoss: &Target{StopPrice: "48000"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid side",
+			params: SetPositionTPSLRequest{
+				Symbol:   "BTC",
+				Side:     OrderSide("invalid"),
+				StopLoss: &Target{StopPrice: "48000"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing both take_profit and stop_loss",
+			params: SetPositionTPSLRequest{
+				Symbol: "BTC",
+				Side:   SideAsk,
+			},
+			wantErr: true,
+		},
+		{
+			name: "take_profit without stop_price",
+			params: SetPositionTPSLRequest{
+				Symbol:     "BTC",
+				Side:       SideAsk,
+				TakeProfit: &Target{LimitPrice: "54950"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := signer.BuildSetPositionTPSLRequest(tt.params, tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.validate != nil {
+				tt.validate(t, req)
+			}
+		})
+	}
+}
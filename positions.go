@@ -0,0 +1,44 @@
+package pacifica
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type positionsResponse struct {
+	Success bool        `json:"success"`
+	Data    []Position  `json:"data"`
+	Error   interface{} `json:"error"`
+	Code    interface{} `json:"code"`
+}
+
+// Position represents an open position of an account.
+// Side is SideBid for a long position and SideAsk for a short one.
+type Position struct {
+	Symbol           string    `json:"symbol"`
+	Side             OrderSide `json:"side"`
+	Amount           string    `json:"amount"`
+	EntryPrice       string    `json:"entry_price"`
+	Margin           string    `json:"margin"`
+	Funding          string    `json:"funding"`
+	Isolated         bool      `json:"isolated"`
+	LiquidationPrice string    `json:"liquidation_price"`
+	CreatedAt        int64     `json:"created_at"`
+	UpdatedAt        int64     `json:"updated_at"`
+}
+
+func (c *RESTClient) GetPositions(ctx context.Context, account string) ([]Position, error) {
+	if account == "" {
+		return nil, fmt.Errorf("positions: account is required")
+	}
+
+	query := url.Values{}
+	query.Set("account", account)
+
+	positionsResp, err := doGet[positionsResponse](ctx, c, "/positions", query)
+	if err != nil {
+		return nil, fmt.Errorf("positions: %w", err)
+	}
+	return positionsResp.Data, nil
+}
@@ -0,0 +1,42 @@
+package pacifica
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRESTClient_GetPositions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/positions", r.URL.Path)
+		assert.Equal(t, testAccountID, r.URL.Query().Get("account"))
+		_, _ = w.Write([]byte(`{"success":true,"data":[{"symbol":"AAVE","side":"ask","amount":"223.72",` +
+			`"entry_price":"279.283134","margin":"0","funding":"13.159593","isolated":false,` +
+			`"liquidation_price":"310.5","created_at":1754928414996,"updated_at":1759223365538}],"error":null,"code":null}`))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, nil)
+
+	positions, err := client.GetPositions(context.Background(), testAccountID)
+	require.NoError(t, err)
+	require.Len(t, positions, 1)
+	assert.Equal(t, Position{
+		Symbol:           "AAVE",
+		Side:             SideAsk,
+		Amount:           "223.72",
+		EntryPrice:       "279.283134",
+		Margin:           "0",
+		Funding:          "13.159593",
+		LiquidationPrice: "310.5",
+		CreatedAt:        1754928414996,
+		UpdatedAt:        1759223365538,
+	}, positions[0])
+
+	_, err = client.GetPositions(context.Background(), "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,170 @@
+package pacifica
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRetryAfter is the pause applied after a 429 response without a Retry-After header
+	defaultRetryAfter = time.Second
+	// defaultMaxRateLimitRetries is the number of resends after 429 responses in RateLimitBlock mode
+	defaultMaxRateLimitRetries = 3
+)
+
+// RateLimitMode defines how the rate limiter behaves when no tokens are available
+type RateLimitMode int
+
+const (
+	// RateLimitBlock waits for tokens and resends requests rejected with 429 after Retry-After
+	RateLimitBlock RateLimitMode = iota
+	// RateLimitFailFast returns ErrRateLimited instead of waiting
+	RateLimitFailFast
+)
+
+// RateLimiterConfig configures a RateLimiter
+type RateLimiterConfig struct {
+	// Capacity is the maximum number of tokens in the bucket
+	Capacity int
+	// RefillRate is the number of tokens added to the bucket per second
+	RefillRate float64
+	// Weights maps endpoint paths, e.g. "/orders/batch", to their cost in tokens. Other endpoints cost 1.
+	Weights map[string]int
+	// Mode defines the behaviour when the bucket is empty or the API returned 429
+	Mode RateLimitMode
+	// MaxRateLimitRetries is the number of times a request rejected with 429 is resent in RateLimitBlock mode,
+	// 3 when zero. The last 429 is then returned as an *APIError matching ErrRateLimited.
+	MaxRateLimitRetries int
+}
+
+// RateLimiter is a token bucket limiting the requests of a RESTClient
+type RateLimiter struct {
+	mu          sync.Mutex
+	capacity    float64
+	refillRate  float64
+	weights     map[string]int
+	mode        RateLimitMode
+	maxRetries  int
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+// NewRateLimiter creates a token bucket rate limiter starting with a full bucket
+func NewRateLimiter(cfg RateLimiterConfig) (*RateLimiter, error) {
+	if cfg.Capacity <= 0 {
+		return nil, fmt.Errorf("rate limiter: capacity must be positive")
+	}
+	if cfg.RefillRate <= 0 {
+		return nil, fmt.Errorf("rate limiter: refill rate must be positive")
+	}
+	if cfg.Mode != RateLimitBlock && cfg.Mode != RateLimitFailFast {
+		return nil, fmt.Errorf("rate limiter: invalid mode: %d", cfg.Mode)
+	}
+	if cfg.MaxRateLimitRetries < 0 {
+		return nil, fmt.Errorf("rate limiter: max rate limit retries must not be negative")
+	}
+	maxRetries := cfg.MaxRateLimitRetries
+	if maxRetries == 0 {
+		maxRetries = defaultMaxRateLimitRetries
+	}
+
+	weights := make(map[string]int, len(cfg.Weights))
+	for path, weight := range cfg.Weights {
+		if weight <= 0 || weight > cfg.Capacity {
+			return nil, fmt.Errorf("rate limiter: weight of %s must be between 1 and the capacity", path)
+		}
+		weights[path] = weight
+	}
+
+	return &RateLimiter{
+		capacity:   float64(cfg.Capacity),
+		refillRate: cfg.RefillRate,
+		weights:    weights,
+		mode:       cfg.Mode,
+		maxRetries: maxRetries,
+		tokens:     float64(cfg.Capacity),
+		last:       time.Now(),
+	}, nil
+}
+
+// Wait takes the tokens of the endpoint at path from the bucket.
+// In RateLimitBlock mode it waits until they are available or ctx is done,
+// in RateLimitFailFast mode it returns ErrRateLimited when they are not.
+func (l *RateLimiter) Wait(ctx context.Context, path string) error {
+	path, _, _ = strings.Cut(path, "?")
+	weight := float64(l.weight(path))
+
+	for {
+		delay := l.reserve(weight)
+		if delay == 0 {
+			return nil
+		}
+		if l.mode == RateLimitFailFast {
+			return fmt.Errorf("rate limiter: %w", ErrRateLimited)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Pause stops handing out tokens for d, e.g. after the API responded with 429
+func (l *RateLimiter) Pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := time.Now().Add(d); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+func (l *RateLimiter) weight(path string) int {
+	if weight, ok := l.weights[path]; ok {
+		return weight
+	}
+	return 1
+}
+
+// reserve takes weight tokens and returns 0, or returns how long to wait before trying again
+func (l *RateLimiter) reserve(weight float64) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(l.pausedUntil) {
+		return l.pausedUntil.Sub(now)
+	}
+
+	l.tokens = min(l.capacity, l.tokens+now.Sub(l.last).Seconds()*l.refillRate)
+	l.last = now
+	if l.tokens >= weight {
+		l.tokens -= weight
+		return 0
+	}
+	return max(time.Millisecond, time.Duration((weight-l.tokens)/l.refillRate*float64(time.Second)))
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(0, time.Duration(seconds)*time.Second)
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(0, time.Until(date))
+	}
+	return 0
+}
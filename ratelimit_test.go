@@ -0,0 +1,189 @@
+package pacifica
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRateLimiter(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     RateLimiterConfig
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			cfg:  RateLimiterConfig{Capacity: 10, RefillRate: 5, Weights: map[string]int{"/orders/batch": 5}},
+		},
+		{
+			name:    "missing capacity",
+			cfg:     RateLimiterConfig{RefillRate: 5},
+			wantErr: true,
+		},
+		{
+			name:    "missing refill rate",
+			cfg:     RateLimiterConfig{Capacity: 10},
+			wantErr: true,
+		},
+		{
+			name:    "weight above capacity",
+			cfg:     RateLimiterConfig{Capacity: 10, RefillRate: 5, Weights: map[string]int{"/orders/batch": 11}},
+			wantErr: true,
+		},
+		{
+			name:    "negative max rate limit retries",
+			cfg:     RateLimiterConfig{Capacity: 10, RefillRate: 5, MaxRateLimitRetries: -1},
+			wantErr: true,
+		},
+		{
+			name:    "invalid mode",
+			cfg:     RateLimiterConfig{Capacity: 10, RefillRate: 5, Mode: RateLimitMode(7)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter, err := NewRateLimiter(tt.cfg)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, limiter)
+		})
+	}
+}
+
+func TestRateLimiterFailFast(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimiterConfig{
+		Capacity:   3,
+		RefillRate: 0.001,
+		Weights:    map[string]int{"/orders/batch": 2},
+		Mode:       RateLimitFailFast,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, limiter.Wait(ctx, "/orders/batch"))
+	require.NoError(t, limiter.Wait(ctx, "/positions?account=test"))
+	assert.ErrorIs(t, limiter.Wait(ctx, "/orders/create"), ErrRateLimited)
+}
+
+func TestRateLimiterBlock(t *testing.T) {
+	limiter, err := NewRateLimiter(RateLimiterConfig{Capacity: 1, RefillRate: 20})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, limiter.Wait(ctx, "/orders/create"))
+
+	start := time.Now()
+	require.NoError(t, limiter.Wait(ctx, "/orders/create"))
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+
+	// Waiting is aborted when the context is done
+	limiter.Pause(time.Hour)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, limiter.Wait(ctx, "/orders/create"), context.DeadlineExceeded)
+}
+
+func TestRESTClientRateLimited(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"error":"Too many requests","code":0}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"order_id":12345}`))
+	}))
+	defer server.Close()
+
+	params := CreateLimitOrderRequest{
+		Symbol: "BTC",
+		Price:  "50000",
+		Amount: "0.1",
+		Side:   SideBid,
+		TIF:    TIFGTC,
+	}
+
+	t.Run("fail fast", func(t *testing.T) {
+		requests = 0
+		limiter, err := NewRateLimiter(RateLimiterConfig{Capacity: 10, RefillRate: 10, Mode: RateLimitFailFast})
+		require.NoError(t, err)
+		client := NewRESTClient(server.URL, signer, WithRateLimiter(limiter))
+
+		_, err = client.CreateLimitOrder(params, nil)
+		assert.ErrorIs(t, err, ErrRateLimited)
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, time.Second, apiErr.RetryAfter)
+
+		// The client stays paused until Retry-After without calling the API
+		_, err = client.CreateLimitOrder(params, nil)
+		assert.ErrorIs(t, err, ErrRateLimited)
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("block", func(t *testing.T) {
+		requests = 0
+		limiter, err := NewRateLimiter(RateLimiterConfig{Capacity: 10, RefillRate: 10, Mode: RateLimitBlock})
+		require.NoError(t, err)
+		client := NewRESTClient(server.URL, signer, WithRateLimiter(limiter))
+
+		start := time.Now()
+		response, err := client.CreateLimitOrder(params, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(12345), response.OrderID)
+		assert.Equal(t, 2, requests)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+}
+
+func TestRESTClientRateLimitRetriesExhausted(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	limiter, err := NewRateLimiter(RateLimiterConfig{Capacity: 10, RefillRate: 10, MaxRateLimitRetries: 1})
+	require.NoError(t, err)
+	client := NewRESTClient(server.URL, signer, WithRateLimiter(limiter))
+
+	_, err = client.CreateLimitOrder(CreateLimitOrderRequest{
+		Symbol: "BTC",
+		Price:  "50000",
+		Amount: "0.1",
+		Side:   SideBid,
+		TIF:    TIFGTC,
+	}, nil)
+	assert.ErrorIs(t, err, ErrRateLimited)
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode)
+	assert.Equal(t, 2, requests)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	assert.Equal(t, time.Duration(0), parseRetryAfter(""))
+	assert.Equal(t, 3*time.Second, parseRetryAfter("3"))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("invalid"))
+
+	date := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	assert.InDelta(t, float64(10*time.Second), float64(parseRetryAfter(date)), float64(2*time.Second))
+}
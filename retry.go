@@ -0,0 +1,202 @@
+package pacifica
+
+import (
+	"context"
+	cryptorand "crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Default values of the zero fields of a RetryPolicy
+const (
+	defaultMaxRetries     = 3
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 5 * time.Second
+)
+
+// orderLookupLimit is the number of recent orders searched for a client order ID before resubmitting
+const orderLookupLimit = 100
+
+// idempotentPaths are the signed endpoints that can be resent without side effects.
+// Read endpoints are always retried, order creation looks the order up before resubmitting it
+// and the other signed endpoints are never retried.
+var idempotentPaths = map[string]bool{
+	"/orders/cancel":      true,
+	"/orders/cancel_all":  true,
+	"/orders/stop/cancel": true,
+	"/positions/tpsl":     true,
+	"/account/leverage":   true,
+	"/account/margin":     true,
+	"/agent/bind":         true,
+	"/agent/revoke":       true,
+}
+
+// RetryPolicy configures the retries of requests failing with a network error or a 5xx response
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt, 3 when zero
+	MaxRetries int
+	// InitialBackoff is the delay before the first retry, 100ms when zero
+	InitialBackoff time.Duration
+	// MaxBackoff caps the exponentially growing delay between retries, 5s when zero
+	MaxBackoff time.Duration
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxRetries == 0 {
+		p.MaxRetries = defaultMaxRetries
+	}
+	if p.InitialBackoff == 0 {
+		p.InitialBackoff = defaultInitialBackoff
+	}
+	if p.MaxBackoff == 0 {
+		p.MaxBackoff = defaultMaxBackoff
+	}
+	return p
+}
+
+// backoff returns the delay before the retry following attempt, doubled on every attempt with equal jitter
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	// Clamp before shifting so that the delay cannot overflow
+	delay := p.MaxBackoff
+	if attempt < 63 && p.InitialBackoff <= p.MaxBackoff>>attempt {
+		delay = p.InitialBackoff << attempt
+	}
+	if delay <= 0 {
+		return 0
+	}
+	half := delay / 2
+	return half + rand.N(half+1)
+}
+
+// wait sleeps for the backoff of attempt or until ctx is done
+func (p RetryPolicy) wait(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(p.backoff(attempt))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// canRetry reports whether a request may be resent by the pipeline
+func (c *RESTClient) canRetry(method, path string) bool {
+	return c.retryPolicy != nil && (method == http.MethodGet || idempotentPaths[path])
+}
+
+// isRetryable reports whether err is a network error, a timeout or a 5xx response
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	// *url.Error implements net.Error itself, so the error it wraps is checked instead
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return false
+	}
+	if urlErr.Timeout() {
+		return true
+	}
+	// The connection was closed before a response was received
+	if errors.Is(urlErr.Err, io.EOF) || errors.Is(urlErr.Err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(urlErr.Err, &netErr)
+}
+
+// isOutcomeUnknown reports whether a request failing with err may still have been processed by the API,
+// that is whether no response was received or the response is a 5xx
+func isOutcomeUnknown(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var urlErr *url.Error
+	return errors.As(err, &urlErr) || isRetryable(ctx, err)
+}
+
+// createOrder submits an order with the retry policy of the client.
+// After a failure leaving the outcome unknown the order is looked up by clientOrderID,
+// and it is only resubmitted when it is not found and the failure is transient.
+func (c *RESTClient) createOrder(ctx context.Context, clientOrderID string, submit func() (int64, error)) (int64, error) {
+	orderID, err := submit()
+	if c.retryPolicy == nil {
+		return orderID, err
+	}
+
+	for attempt := 0; err != nil && isOutcomeUnknown(ctx, err) && attempt < c.retryPolicy.MaxRetries; attempt++ {
+		if waitErr := c.retryPolicy.wait(ctx, attempt); waitErr != nil {
+			return 0, waitErr
+		}
+
+		order, lookupErr := c.findOrder(ctx, clientOrderID)
+		if lookupErr != nil {
+			// The order state is unknown, never resubmit without a successful lookup
+			c.logErrf("order %s: %v", clientOrderID, lookupErr)
+			continue
+		}
+		if order != nil {
+			c.logInfof("order %s: found order %d after error: %v", clientOrderID, order.OrderID, err)
+			return order.OrderID, nil
+		}
+		if !isRetryable(ctx, err) {
+			return orderID, err
+		}
+
+		c.logInfof("order %s: resubmitting after error: %v", clientOrderID, err)
+		orderID, err = submit()
+	}
+	return orderID, err
+}
+
+// findOrder searches the open and recent orders of the client account for clientOrderID
+func (c *RESTClient) findOrder(ctx context.Context, clientOrderID string) (*Order, error) {
+	account := c.signer.AccountID()
+
+	openOrders, err := c.GetOpenOrders(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up order: %w", err)
+	}
+	for _, order := range openOrders {
+		if order.ClientOrderID == clientOrderID {
+			return &order, nil
+		}
+	}
+
+	// Orders filled or cancelled right away are only listed in the history
+	history, err := c.GetOrderHistory(ctx, account, OrderHistoryParams{Limit: orderLookupLimit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up order: %w", err)
+	}
+	for _, order := range history.Orders {
+		if order.ClientOrderID == clientOrderID {
+			return &order, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// newClientOrderID returns a random UUID v4
+func newClientOrderID() string {
+	var b [16]byte
+	_, _ = cryptorand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,260 @@
+package pacifica
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{}.withDefaults()
+	assert.Equal(t, defaultMaxRetries, policy.MaxRetries)
+
+	policy = RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		backoff := policy.backoff(attempt)
+		assert.GreaterOrEqual(t, backoff, want/2)
+		assert.LessOrEqual(t, backoff, want)
+	}
+	assert.LessOrEqual(t, policy.backoff(100), time.Second)
+
+	// Large initial backoffs are capped instead of overflowing
+	policy = RetryPolicy{InitialBackoff: 5 * time.Second, MaxBackoff: time.Minute}
+	for _, attempt := range []int{31, 62, 63, 100} {
+		backoff := policy.backoff(attempt)
+		assert.GreaterOrEqual(t, backoff, 30*time.Second)
+		assert.LessOrEqual(t, backoff, time.Minute)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	ctx := context.Background()
+
+	assert.True(t, isRetryable(ctx, &APIError{StatusCode: http.StatusBadGateway}))
+	assert.False(t, isRetryable(ctx, &APIError{StatusCode: http.StatusBadRequest}))
+	assert.False(t, isRetryable(ctx, errors.New("failed to build signed request")))
+
+	// Connection failures and timeouts are transient
+	_, err := http.Get("http://127.0.0.1:1")
+	require.Error(t, err)
+	assert.True(t, isRetryable(ctx, err))
+
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer slow.Close()
+	_, err = (&http.Client{Timeout: 10 * time.Millisecond}).Get(slow.URL)
+	require.Error(t, err)
+	assert.True(t, isRetryable(ctx, err))
+
+	// Connections closed before a response are transient
+	assert.True(t, isRetryable(ctx, &url.Error{Op: "Post", URL: "http://127.0.0.1", Err: io.EOF}))
+	assert.True(t, isRetryable(ctx, &url.Error{Op: "Post", URL: "http://127.0.0.1", Err: io.ErrUnexpectedEOF}))
+
+	// Malformed requests fail the same way on every attempt
+	_, err = http.Get("ftp://127.0.0.1")
+	require.Error(t, err)
+	assert.False(t, isRetryable(ctx, err))
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.False(t, isRetryable(cancelled, &APIError{StatusCode: http.StatusBadGateway}))
+}
+
+func TestRESTClientRetries(t *testing.T) {
+	signer := generateTestExchange(t)
+	retryPolicy := RetryPolicy{MaxRetries: 2, InitialBackoff: time.Millisecond}
+
+	t.Run("read endpoints are retried on 5xx", func(t *testing.T) {
+		var requests int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if requests < 3 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			_, _ = w.Write([]byte(`{"success":true,"data":[],"error":null,"code":null}`))
+		}))
+		defer server.Close()
+
+		client := NewRESTClient(server.URL, signer, WithRetryPolicy(retryPolicy))
+		_, err := client.GetPositions(context.Background(), testAccountID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, requests)
+	})
+
+	t.Run("retries are limited", func(t *testing.T) {
+		var requests int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client := NewRESTClient(server.URL, signer, WithRetryPolicy(retryPolicy))
+		_, err := client.GetPositions(context.Background(), testAccountID)
+		require.Error(t, err)
+		assert.Equal(t, 3, requests)
+	})
+
+	t.Run("client errors are not retried", func(t *testing.T) {
+		var requests int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"Order not found","code":5}`))
+		}))
+		defer server.Close()
+
+		client := NewRESTClient(server.URL, signer, WithRetryPolicy(retryPolicy))
+		_, err := client.CancelOrder(CancelOrderRequest{Symbol: "BTC", OrderID: intPtr(12345)}, nil)
+		assert.ErrorIs(t, err, ErrOrderNotFound)
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("non idempotent endpoints are not retried", func(t *testing.T) {
+		var requests int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client := NewRESTClient(server.URL, signer, WithRetryPolicy(retryPolicy))
+		_, err := client.Withdraw(WithdrawRequest{Amount: "100"}, nil)
+		require.Error(t, err)
+		assert.Equal(t, 1, requests)
+	})
+}
+
+func TestRESTClientCreateOrderRetries(t *testing.T) {
+	signer := generateTestExchange(t)
+	params := CreateLimitOrderRequest{
+		Symbol: "BTC",
+		Price:  "50000",
+		Amount: "0.1",
+		Side:   SideBid,
+		TIF:    TIFGTC,
+	}
+
+	t.Run("order created despite the failure is not resubmitted", func(t *testing.T) {
+		var creates int
+		var clientOrderID string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/orders/create":
+				creates++
+				var body map[string]interface{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				clientOrderID, _ = body["client_order_id"].(string)
+				w.WriteHeader(http.StatusGatewayTimeout)
+			case "/orders":
+				_, _ = w.Write([]byte(`{"success":true,"data":[{"order_id":777,"client_order_id":"` + clientOrderID +
+					`","symbol":"BTC","order_status":"open"}],"error":null,"code":null}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		client := NewRESTClient(server.URL, signer, WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond}))
+		response, err := client.CreateLimitOrder(params, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(777), response.OrderID)
+		assert.Equal(t, 1, creates)
+		assert.Regexp(t, uuidPattern, clientOrderID)
+	})
+
+	t.Run("missing order is resubmitted with the same client order ID", func(t *testing.T) {
+		var clientOrderIDs []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/orders/create":
+				var body map[string]interface{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				clientOrderIDs = append(clientOrderIDs, body["client_order_id"].(string))
+				if len(clientOrderIDs) == 1 {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				_, _ = w.Write([]byte(`{"order_id":12345}`))
+			case "/orders", "/orders/history":
+				_, _ = w.Write([]byte(`{"success":true,"data":[],"error":null,"code":null}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		client := NewRESTClient(server.URL, signer, WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond}))
+		params := params
+		params.ClientOrderID = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+		response, err := client.CreateLimitOrder(params, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(12345), response.OrderID)
+		assert.Equal(t, []string{params.ClientOrderID, params.ClientOrderID}, clientOrderIDs)
+	})
+
+	t.Run("order is looked up after the connection is dropped", func(t *testing.T) {
+		var creates int
+		var clientOrderID string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/orders/create":
+				creates++
+				var body map[string]interface{}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				clientOrderID, _ = body["client_order_id"].(string)
+				conn, _, err := w.(http.Hijacker).Hijack()
+				require.NoError(t, err)
+				_ = conn.Close()
+			case "/orders":
+				_, _ = w.Write([]byte(`{"success":true,"data":[{"order_id":777,"client_order_id":"` + clientOrderID +
+					`","symbol":"BTC","order_status":"open"}],"error":null,"code":null}`))
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		client := NewRESTClient(server.URL, signer, WithRetryPolicy(RetryPolicy{InitialBackoff: time.Millisecond}))
+		response, err := client.CreateLimitOrder(params, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(777), response.OrderID)
+		assert.Equal(t, 1, creates)
+	})
+
+	t.Run("client order ID is only generated with a retry policy", func(t *testing.T) {
+		var clientOrderID string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			clientOrderID, _ = body["client_order_id"].(string)
+			_, _ = w.Write([]byte(`{"order_id":12345}`))
+		}))
+		defer server.Close()
+
+		client := NewRESTClient(server.URL, signer)
+		_, err := client.CreateLimitOrder(params, nil)
+		require.NoError(t, err)
+		assert.Empty(t, clientOrderID)
+	})
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+func TestNewClientOrderID(t *testing.T) {
+	id := newClientOrderID()
+	assert.Regexp(t, uuidPattern, id)
+	assert.NotEqual(t, id, newClientOrderID())
+}
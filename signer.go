@@ -0,0 +1,49 @@
+package pacifica
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"fmt"
+)
+
+// Signer signs Pacifica messages with an ed25519 key that may live outside the process
+type Signer interface {
+	// PublicKey returns the ed25519 public key of the signer
+	PublicKey() ed25519.PublicKey
+	// Sign returns the ed25519 signature of the message
+	Sign(ctx context.Context, message []byte) ([]byte, error)
+}
+
+// PrivateKeySigner is a Signer holding the ed25519 private key in memory
+type PrivateKeySigner struct {
+	privateKey ed25519.PrivateKey
+	publicKey  ed25519.PublicKey
+}
+
+// NewPrivateKeySigner creates an in-memory signer from an ed25519 private key
+func NewPrivateKeySigner(privateKey ed25519.PrivateKey) (*PrivateKeySigner, error) {
+	if len(privateKey) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("invalid private key length: got %d bytes, want %d", len(privateKey), ed25519.PrivateKeySize)
+	}
+
+	// The second half of a private key is the public key of the seed in the first half
+	if !bytes.Equal(ed25519.NewKeyFromSeed(privateKey.Seed()), privateKey) {
+		return nil, fmt.Errorf("public key does not match private key")
+	}
+
+	return &PrivateKeySigner{
+		privateKey: privateKey,
+		publicKey:  privateKey.Public().(ed25519.PublicKey),
+	}, nil
+}
+
+// PublicKey returns the ed25519 public key of the signer
+func (s *PrivateKeySigner) PublicKey() ed25519.PublicKey {
+	return s.publicKey
+}
+
+// Sign returns the ed25519 signature of the message
+func (s *PrivateKeySigner) Sign(_ context.Context, message []byte) ([]byte, error) {
+	return ed25519.Sign(s.privateKey, message), nil
+}
@@ -0,0 +1,197 @@
+package pacifica
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mr-tron/base58"
+)
+
+// Signing daemon protocol:
+//
+//	GET  /public_key -> {"public_key": "<base58>"}
+//	POST /sign {"message": "<base64>"} -> {"signature": "<base58>"}
+//
+// Failures are reported with a non-200 status and {"error": "<message>"}.
+const (
+	signerDaemonPublicKeyPath = "/public_key"
+	signerDaemonSignPath      = "/sign"
+)
+
+type signerDaemonPublicKeyResponse struct {
+	PublicKey string `json:"public_key"`
+}
+
+type signerDaemonSignRequest struct {
+	Message string `json:"message"`
+}
+
+type signerDaemonSignResponse struct {
+	Signature string `json:"signature"`
+}
+
+type signerDaemonError struct {
+	Error string `json:"error"`
+}
+
+// DaemonSigner is a Signer delegating signatures to a local signing daemon over a Unix socket or HTTP
+type DaemonSigner struct {
+	baseURL    string
+	httpClient *http.Client
+	publicKey  ed25519.PublicKey
+}
+
+// NewDaemonSigner connects to the signing daemon at address and fetches its public key.
+// The address is either a Unix socket, e.g. "unix:///run/pacifica-signer.sock", or an HTTP URL.
+func NewDaemonSigner(ctx context.Context, address string) (*DaemonSigner, error) {
+	s := &DaemonSigner{
+		httpClient: &http.Client{
+			Timeout: 5 * time.Second,
+		},
+	}
+
+	if socketPath, ok := strings.CutPrefix(address, "unix://"); ok {
+		if socketPath == "" {
+			return nil, fmt.Errorf("signer daemon: socket path is required")
+		}
+		var dialer net.Dialer
+		s.httpClient.Transport = &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return dialer.DialContext(ctx, "unix", socketPath)
+			},
+		}
+		s.baseURL = "http://unix"
+	} else if strings.HasPrefix(address, "http://") || strings.HasPrefix(address, "https://") {
+		s.baseURL = strings.TrimSuffix(address, "/")
+	} else {
+		return nil, fmt.Errorf("signer daemon: unsupported address: %s", address)
+	}
+
+	var publicKeyResp signerDaemonPublicKeyResponse
+	if err := s.do(ctx, http.MethodGet, signerDaemonPublicKeyPath, nil, &publicKeyResp); err != nil {
+		return nil, fmt.Errorf("signer daemon: error fetching public key: %w", err)
+	}
+
+	publicKey, err := base58.Decode(publicKeyResp.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("signer daemon: error decoding public key: %w", err)
+	}
+	if len(publicKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("signer daemon: invalid public key length: %d", len(publicKey))
+	}
+	s.publicKey = publicKey
+
+	return s, nil
+}
+
+// PublicKey returns the ed25519 public key of the daemon
+func (s *DaemonSigner) PublicKey() ed25519.PublicKey {
+	return s.publicKey
+}
+
+// Sign asks the daemon to sign the message and verifies the returned signature
+func (s *DaemonSigner) Sign(ctx context.Context, message []byte) ([]byte, error) {
+	var signResp signerDaemonSignResponse
+	err := s.do(ctx, http.MethodPost, signerDaemonSignPath, signerDaemonSignRequest{
+		Message: base64.StdEncoding.EncodeToString(message),
+	}, &signResp)
+	if err != nil {
+		return nil, fmt.Errorf("signer daemon: error signing message: %w", err)
+	}
+
+	signature, err := base58.Decode(signResp.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("signer daemon: error decoding signature: %w", err)
+	}
+	if !ed25519.Verify(s.publicKey, message, signature) {
+		return nil, fmt.Errorf("signer daemon: invalid signature")
+	}
+
+	return signature, nil
+}
+
+func (s *DaemonSigner) do(ctx context.Context, method, path string, in, out interface{}) error {
+	body := io.Reader(http.NoBody)
+	if in != nil {
+		jsonData, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("error marshaling request: %w", err)
+		}
+		body = bytes.NewReader(jsonData)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error performing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var daemonErr signerDaemonError
+		if err := json.NewDecoder(resp.Body).Decode(&daemonErr); err == nil && daemonErr.Error != "" {
+			return fmt.Errorf("status code %d: %s", resp.StatusCode, daemonErr.Error)
+		}
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+	return nil
+}
+
+// NewSignerDaemonHandler serves the signing daemon protocol for the given signer.
+// It can back a local stand-in daemon listening on a Unix socket.
+func NewSignerDaemonHandler(signer Signer) http.Handler {
+	writeJSON := func(w http.ResponseWriter, status int, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(v)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+signerDaemonPublicKeyPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, signerDaemonPublicKeyResponse{
+			PublicKey: base58.Encode(signer.PublicKey()),
+		})
+	})
+	mux.HandleFunc("POST "+signerDaemonSignPath, func(w http.ResponseWriter, r *http.Request) {
+		var signReq signerDaemonSignRequest
+		if err := json.NewDecoder(r.Body).Decode(&signReq); err != nil {
+			writeJSON(w, http.StatusBadRequest, signerDaemonError{Error: "invalid request"})
+			return
+		}
+		message, err := base64.StdEncoding.DecodeString(signReq.Message)
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, signerDaemonError{Error: "invalid message encoding"})
+			return
+		}
+
+		signature, err := signer.Sign(r.Context(), message)
+		if err != nil {
+			writeJSON(w, http.StatusInternalServerError, signerDaemonError{Error: err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusOK, signerDaemonSignResponse{
+			Signature: base58.Encode(signature),
+		})
+	})
+	return mux
+}
@@ -0,0 +1,149 @@
+package pacifica
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// startSignerDaemon serves the signing daemon protocol on a temporary Unix socket
+func startSignerDaemon(t *testing.T, signer Signer) string {
+	dir, err := os.MkdirTemp("", "pacifica-signer")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	socketPath := filepath.Join(dir, "signer.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+
+	server := &http.Server{Handler: NewSignerDaemonHandler(signer)}
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(func() { _ = server.Close() })
+
+	return "unix://" + socketPath
+}
+
+func TestDaemonSigner(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	local, err := NewPrivateKeySigner(privateKey)
+	require.NoError(t, err)
+
+	address := startSignerDaemon(t, local)
+
+	signer, err := NewDaemonSigner(context.Background(), address)
+	require.NoError(t, err)
+	assert.Equal(t, publicKey, signer.PublicKey())
+
+	signature, err := signer.Sign(context.Background(), []byte("test message"))
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(publicKey, []byte("test message"), signature))
+
+	// Exchange signs through the daemon
+	exchange, err := NewExchangeWithSigner(signer, testAccountID)
+	require.NoError(t, err)
+	request, err := exchange.BuildSignedRequest("create_order", map[string]interface{}{"symbol": "BTC"}, 5000)
+	require.NoError(t, err)
+
+	message, err := createCompactJSON(map[string]interface{}{
+		"timestamp":     request["timestamp"],
+		"expiry_window": request["expiry_window"],
+		"type":          "create_order",
+		"data":          map[string]interface{}{"symbol": "BTC"},
+	})
+	require.NoError(t, err)
+	assert.True(t, local.PublicKey().Equal(exchange.publicKey))
+	assert.True(t, exchange.VerifySignature(message, request["signature"].(string)))
+}
+
+type failingSigner struct {
+	publicKey ed25519.PublicKey
+}
+
+func (s failingSigner) PublicKey() ed25519.PublicKey {
+	return s.publicKey
+}
+
+func (s failingSigner) Sign(context.Context, []byte) ([]byte, error) {
+	return nil, errors.New("signing refused")
+}
+
+func TestDaemonSignerErrors(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(NewSignerDaemonHandler(failingSigner{publicKey: publicKey}))
+	defer server.Close()
+
+	signer, err := NewDaemonSigner(context.Background(), server.URL)
+	require.NoError(t, err)
+
+	_, err = signer.Sign(context.Background(), []byte("test message"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "signing refused")
+
+	_, err = NewDaemonSigner(context.Background(), "tcp://127.0.0.1:1")
+	assert.Error(t, err)
+
+	_, err = NewDaemonSigner(context.Background(), "unix://")
+	assert.Error(t, err)
+}
+
+type blockingSigner struct {
+	publicKey ed25519.PublicKey
+}
+
+func (s blockingSigner) PublicKey() ed25519.PublicKey {
+	return s.publicKey
+}
+
+func (s blockingSigner) Sign(ctx context.Context, _ []byte) ([]byte, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestDaemonSignerContext(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	daemon := httptest.NewServer(NewSignerDaemonHandler(blockingSigner{publicKey: publicKey}))
+	defer daemon.Close()
+
+	var requests int
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer api.Close()
+
+	signer, err := NewDaemonSigner(context.Background(), daemon.URL)
+	require.NoError(t, err)
+	exchange, err := NewExchangeWithSigner(signer, testAccountID)
+	require.NoError(t, err)
+	client := NewRESTClient(api.URL, exchange)
+
+	// Signing is aborted when the context of the request is done
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.CreateLimitOrderWithContext(ctx, CreateLimitOrderRequest{
+		Symbol: "BTC",
+		Price:  "50000",
+		Amount: "0.1",
+		Side:   SideBid,
+		TIF:    TIFGTC,
+	}, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 0, requests)
+
+	_, err = exchange.BuildSignedRequestWithContext(ctx, "create_order", map[string]interface{}{"symbol": "BTC"}, 5000)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,55 @@
+package pacifica
+
+import (
+	"context"
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivateKeySigner(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	signer, err := NewPrivateKeySigner(privateKey)
+	require.NoError(t, err)
+	assert.Equal(t, publicKey, signer.PublicKey())
+
+	signature, err := signer.Sign(context.Background(), []byte("test message"))
+	require.NoError(t, err)
+	assert.True(t, ed25519.Verify(publicKey, []byte("test message"), signature))
+
+	_, err = NewPrivateKeySigner(privateKey[:32])
+	assert.Error(t, err)
+}
+
+func TestNewExchangeWithSigner(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	signer, err := NewPrivateKeySigner(privateKey)
+	require.NoError(t, err)
+
+	exchange, err := NewExchangeWithSigner(signer, "")
+	require.NoError(t, err)
+	assert.False(t, exchange.IsAgent())
+
+	header, signature, err := exchange.CreateSignature("create_order", map[string]interface{}{"symbol": "BTC"}, 0)
+	require.NoError(t, err)
+	message, err := createCompactJSON(map[string]interface{}{
+		"timestamp":     header.Timestamp,
+		"expiry_window": header.ExpiryWindow,
+		"type":          header.Type,
+		"data":          map[string]interface{}{"symbol": "BTC"},
+	})
+	require.NoError(t, err)
+	assert.True(t, exchange.VerifySignature(message, signature))
+
+	agent, err := NewAgentExchangeWithSigner(signer, testAccountID)
+	require.NoError(t, err)
+	assert.True(t, agent.IsAgent())
+
+	_, err = NewExchangeWithSigner(nil, testAccountID)
+	assert.Error(t, err)
+}
@@ -0,0 +1,181 @@
+package pacifica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// StopOrder represents the stop leg of a stop order.
+// Leaving LimitPrice empty places a stop-market order, otherwise a stop-limit order is placed.
+type StopOrder struct {
+	StopPrice     string `json:"stop_price"`
+	LimitPrice    string `json:"limit_price,omitempty"`
+	Amount        string `json:"amount"`
+	ClientOrderID string `json:"client_order_id,omitempty"`
+}
+
+// CreateStopOrderRequest represents the request data for creating a stop order
+type CreateStopOrderRequest struct {
+	Symbol     string    `json:"symbol"`
+	Side       OrderSide `json:"side"`
+	ReduceOnly bool      `json:"reduce_only"`
+	StopOrder  StopOrder `json:"stop_order"`
+}
+
+func (r CreateStopOrderRequest) String() string {
+	data, _ := json.Marshal(r)
+	return string(data)
+}
+
+// CreateStopOrderOptions contains optional parameters for creating a stop order
+type CreateStopOrderOptions struct {
+	AgentWallet  *string
+	ExpiryWindow int64
+}
+
+// BuildCreateStopOrderRequest builds a signed request for creating a stop order
+func (s *Exchange) BuildCreateStopOrderRequest(params CreateStopOrderRequest, opts *CreateStopOrderOptions) (map[string]interface{}, error) {
+	return buildCreateStopOrderRequest(context.Background(), s, params, opts)
+}
+
+// buildCreateStopOrderRequest is BuildCreateStopOrderRequest passing ctx to the signer
+func buildCreateStopOrderRequest(ctx context.Context, s *Exchange, params CreateStopOrderRequest, opts *CreateStopOrderOptions) (map[string]interface{}, error) {
+	// Validate required fields
+	if params.Symbol == "" {
+		return nil, fmt.Errorf("symbol is required")
+	}
+	if params.Side != SideBid && params.Side != SideAsk {
+		return nil, fmt.Errorf("side must be 'bid' or 'ask'")
+	}
+	if params.StopOrder.StopPrice == "" {
+		return nil, fmt.Errorf("stop_price is required")
+	}
+	if params.StopOrder.Amount == "" {
+		return nil, fmt.Errorf("amount is required")
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal params: %w", err)
+	}
+
+	var operationData map[string]interface{}
+	if err := json.Unmarshal(data, &operationData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal params: %w", err)
+	}
+
+	// Determine expiry window
+	expiryWindow := int64(0)
+	if opts != nil && opts.ExpiryWindow != 0 {
+		expiryWindow = opts.ExpiryWindow
+	}
+
+	// Build signed request with operation type "create_stop_order"
+	request, err := s.BuildSignedRequestWithContext(ctx, "create_stop_order", operationData, expiryWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build signed request: %w", err)
+	}
+
+	// Add agent_wallet if provided
+	if opts != nil && opts.AgentWallet != nil {
+		request["agent_wallet"] = *opts.AgentWallet
+	}
+
+	return request, nil
+}
+
+// CreateStopOrderResponse represents the response from the create stop order endpoint
+type CreateStopOrderResponse struct {
+	OrderID int64 `json:"order_id"`
+}
+
+// CreateStopOrder creates a standalone stop order on Pacifica
+func (c *RESTClient) CreateStopOrder(params CreateStopOrderRequest, opts *CreateStopOrderOptions) (*CreateStopOrderResponse, error) {
+	return c.CreateStopOrderWithContext(context.Background(), params, opts)
+}
+
+// CreateStopOrderWithContext is like CreateStopOrder but aborts the HTTP request when ctx is done
+func (c *RESTClient) CreateStopOrderWithContext(ctx context.Context, params CreateStopOrderRequest, opts *CreateStopOrderOptions) (*CreateStopOrderResponse, error) {
+	return doSigned[CreateStopOrderRequest, CreateStopOrderResponse](ctx, c, "/orders/stop/create", buildCreateStopOrderRequest, params, opts)
+}
+
+// CancelStopOrderRequest represents the request data for canceling a stop order
+type CancelStopOrderRequest struct {
+	Symbol        string `json:"symbol"`
+	OrderID       *int64 `json:"order_id,omitempty"`
+	ClientOrderID string `json:"client_order_id,omitempty"`
+}
+
+func (r CancelStopOrderRequest) String() string {
+	data, _ := json.Marshal(r)
+	return string(data)
+}
+
+// CancelStopOrderOptions contains optional parameters for canceling a stop order
+type CancelStopOrderOptions struct {
+	AgentWallet  *string
+	ExpiryWindow int64
+}
+
+// BuildCancelStopOrderRequest builds a signed request for canceling a stop order
+func (s *Exchange) BuildCancelStopOrderRequest(params CancelStopOrderRequest, opts *CancelStopOrderOptions) (map[string]interface{}, error) {
+	return buildCancelStopOrderRequest(context.Background(), s, params, opts)
+}
+
+// buildCancelStopOrderRequest is BuildCancelStopOrderRequest passing ctx to the signer
+func buildCancelStopOrderRequest(ctx context.Context, s *Exchange, params CancelStopOrderRequest, opts *CancelStopOrderOptions) (map[string]interface{}, error) {
+	// Validate required fields
+	if params.Symbol == "" {
+		return nil, fmt.Errorf("symbol is required")
+	}
+
+	// Either order_id or client_order_id must be provided
+	if params.OrderID == nil && params.ClientOrderID == "" {
+		return nil, fmt.Errorf("either order_id or client_order_id is required")
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal params: %w", err)
+	}
+
+	var operationData map[string]interface{}
+	if err := json.Unmarshal(data, &operationData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal params: %w", err)
+	}
+
+	// Determine expiry window
+	expiryWindow := int64(0)
+	if opts != nil && opts.ExpiryWindow != 0 {
+		expiryWindow = opts.ExpiryWindow
+	}
+
+	// Build signed request with operation type "cancel_stop_order"
+	request, err := s.BuildSignedRequestWithContext(ctx, "cancel_stop_order", operationData, expiryWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build signed request: %w", err)
+	}
+
+	// Add agent_wallet if provided
+	if opts != nil && opts.AgentWallet != nil {
+		request["agent_wallet"] = *opts.AgentWallet
+	}
+
+	return request, nil
+}
+
+// CancelStopOrderResponse represents the response from the cancel stop order endpoint
+type CancelStopOrderResponse struct {
+	Success bool `json:"success"`
+}
+
+// CancelStopOrder cancels a stop order on Pacifica
+func (c *RESTClient) CancelStopOrder(params CancelStopOrderRequest, opts *CancelStopOrderOptions) (*CancelStopOrderResponse, error) {
+	return c.CancelStopOrderWithContext(context.Background(), params, opts)
+}
+
+// CancelStopOrderWithContext is like CancelStopOrder but aborts the HTTP request when ctx is done
+func (c *RESTClient) CancelStopOrderWithContext(ctx context.Context, params CancelStopOrderRequest, opts *CancelStopOrderOptions) (*CancelStopOrderResponse, error) {
+	return doSigned[CancelStopOrderRequest, CancelStopOrderResponse](ctx, c, "/orders/stop/cancel", buildCancelStopOrderRequest, params, opts)
+}
@@ -0,0 +1,196 @@
+package pacifica
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCreateStopOrderRequest(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	tests := []struct {
+		name     string
+		params   CreateStopOrderRequest
+		opts     *CreateStopOrderOptions
+		wantErr  bool
+		validate func(*testing.T, map[string]interface{})
+	}{
+		{
+			name: "stop market order",
+			params: CreateStopOrderRequest{
+				Symbol:     "BTC",
+				Side:       SideAsk,
+				ReduceOnly: true,
+				StopOrder: StopOrder{
+					StopPrice: "48000",
+					Amount:    "0.1",
+				},
+			},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				assert.Equal(t, "BTC", req["symbol"])
+				assert.Equal(t, "ask", req["side"])
+				assert.Equal(t, true, req["reduce_only"])
+				stopOrder, ok := req["stop_order"].(map[string]interface{})
+				require.True(t, ok)
+				assert.Equal(t, "48000", stopOrder["stop_price"])
+				assert.Equal(t, "0.1", stopOrder["amount"])
+				assert.NotContains(t, stopOrder, "limit_price")
+				assert.NotContains(t, stopOrder, "client_order_id")
+				assert.Contains(t, req, "account")
+				assert.Contains(t, req, "signature")
+				assert.Contains(t, req, "timestamp")
+			},
+		},
+		{
+			name: "stop limit order with client_order_id",
+			params: CreateStopOrderRequest{
+				Symbol: "ETH",
+				Side:   SideBid,
+				StopOrder: StopOrder{
+					StopPrice:     "2100",
+					LimitPrice:    "2105",
+					Amount:        "1.5",
+					ClientOrderID: "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+				},
+			},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				stopOrder, ok := req["stop_order"].(map[string]interface{})
+				require.True(t, ok)
+				assert.Equal(t, "2100", stopOrder["stop_price"])
+				assert.Equal(t, "2105", stopOrder["limit_price"])
+				assert.Equal(t, "f47ac10b-58cc-4372-a567-0e02b2c3d479", stopOrder["client_order_id"])
+			},
+		},
+		{
+			name: "stop order with agent_wallet and expiry_window",
+			params: CreateStopOrderRequest{
+				Symbol:    "BTC",
+				Side:      SideAsk,
+				StopOrder: StopOrder{StopPrice: "48000", Amount: "0.1"},
+			},
+			opts: &CreateStopOrderOptions{
+				AgentWallet:  stringPtr("69trU9A5..."),
+				ExpiryWindow: 10000,
+			},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				assert.Equal(t, "69trU9A5...", req["agent_wallet"])
+				assert.Equal(t, int64(10000), req["expiry_window"])
+			},
+		},
+		{
+			name: "missing symbol",
+			params: CreateStopOrderRequest{
+				Side:      SideAsk,
+				StopOrder: StopOrder{StopPrice: "48000", Amount: "0.1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid side",
+			params: CreateStopOrderRequest{
+				Symbol:    "BTC",
+				Side:      OrderSide("invalid"),
+				StopOrder: StopOrder{StopPrice: "48000", Amount: "0.1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing stop_price",
+			params: CreateStopOrderRequest{
+				Symbol:    "BTC",
+				Side:      SideAsk,
+				StopOrder: StopOrder{Amount: "0.1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing amount",
+			params: CreateStopOrderRequest{
+				Symbol:    "BTC",
+				Side:      SideAsk,
+				StopOrder: StopOrder{StopPrice: "48000"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := signer.BuildCreateStopOrderRequest(tt.params, tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.validate != nil {
+				tt.validate(t, req)
+			}
+		})
+	}
+}
+
+func TestBuildCancelStopOrderRequest(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	tests := []struct {
+		name     string
+		params   CancelStopOrderRequest
+		opts     *CancelStopOrderOptions
+		wantErr  bool
+		validate func(*testing.T, map[string]interface{})
+	}{
+		{
+			name: "cancel stop order with order_id",
+			params: CancelStopOrderRequest{
+				Symbol:  "BTC",
+				OrderID: intPtr(12345),
+			},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				assert.Equal(t, "BTC", req["symbol"])
+				assert.Equal(t, float64(12345), req["order_id"])
+				assert.NotContains(t, req, "client_order_id")
+			},
+		},
+		{
+			name: "cancel stop order with client_order_id",
+			params: CancelStopOrderRequest{
+				Symbol:        "BTC",
+				ClientOrderID: "f47ac10b-58cc-4372-a567-0e02b2c3d479",
+			},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				assert.Equal(t, "f47ac10b-58cc-4372-a567-0e02b2c3d479", req["client_order_id"])
+				assert.NotContains(t, req, "order_id")
+			},
+		},
+		{
+			name: "missing symbol",
+			params: CancelStopOrderRequest{
+				OrderID: intPtr(12345),
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing both order_id and client_order_id",
+			params: CancelStopOrderRequest{
+				Symbol: "BTC",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := signer.BuildCancelStopOrderRequest(tt.params, tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.validate != nil {
+				tt.validate(t, req)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package pacifica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// CreateSubaccountOptions contains optional parameters for creating a subaccount
+type CreateSubaccountOptions struct {
+	ExpiryWindow int64
+}
+
+// BuildCreateSubaccountRequest builds a request for creating a subaccount owned by this account.
+// The main account signs a "subaccount_initiate" operation for the subaccount public key and
+// the subaccount confirms it by signing the main signature with a "subaccount_confirm" operation.
+func (s *Exchange) BuildCreateSubaccountRequest(sub *Exchange, opts *CreateSubaccountOptions) (map[string]interface{}, error) {
+	return buildCreateSubaccountRequest(context.Background(), s, sub, opts)
+}
+
+// buildCreateSubaccountRequest is BuildCreateSubaccountRequest passing ctx to the signer
+func buildCreateSubaccountRequest(ctx context.Context, s *Exchange, sub *Exchange, opts *CreateSubaccountOptions) (map[string]interface{}, error) {
+	if sub == nil {
+		return nil, fmt.Errorf("subaccount signer is required")
+	}
+	if s.IsAgent() {
+		return nil, fmt.Errorf("subaccounts must be created with the account owner key")
+	}
+	subaccount := sub.GetPublicKey()
+	if subaccount == s.GetPublicKey() {
+		return nil, fmt.Errorf("subaccount key must differ from main account key")
+	}
+
+	// Determine expiry window
+	expiryWindow := int64(0)
+	if opts != nil && opts.ExpiryWindow != 0 {
+		expiryWindow = opts.ExpiryWindow
+	}
+
+	// Both signatures must share the same timestamp
+	timestamp := time.Now().UnixMilli()
+
+	mainHeader, mainSignature, err := s.createSignatureAt(ctx, "subaccount_initiate", map[string]interface{}{
+		"account": subaccount,
+	}, timestamp, expiryWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create main signature: %w", err)
+	}
+
+	_, subSignature, err := sub.createSignatureAt(ctx, "subaccount_confirm", map[string]interface{}{
+		"signature": mainSignature,
+	}, timestamp, mainHeader.ExpiryWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create subaccount signature: %w", err)
+	}
+
+	return map[string]interface{}{
+		"main_account":   s.accountID,
+		"subaccount":     subaccount,
+		"main_signature": mainSignature,
+		"sub_signature":  subSignature,
+		"timestamp":      mainHeader.Timestamp,
+		"expiry_window":  mainHeader.ExpiryWindow,
+	}, nil
+}
+
+// CreateSubaccountResponse represents the response from the create subaccount endpoint
+type CreateSubaccountResponse struct {
+	Success bool `json:"success"`
+}
+
+// CreateSubaccount creates a subaccount owned by the client account on Pacifica
+func (c *RESTClient) CreateSubaccount(sub *Exchange, opts *CreateSubaccountOptions) (*CreateSubaccountResponse, error) {
+	return c.CreateSubaccountWithContext(context.Background(), sub, opts)
+}
+
+// CreateSubaccountWithContext is like CreateSubaccount but aborts the HTTP request when ctx is done
+func (c *RESTClient) CreateSubaccountWithContext(ctx context.Context, sub *Exchange, opts *CreateSubaccountOptions) (*CreateSubaccountResponse, error) {
+	return doSigned[*Exchange, CreateSubaccountResponse](ctx, c, "/account/subaccount/create", buildCreateSubaccountRequest, sub, opts)
+}
+
+// TransferFundsRequest represents the request data for transferring collateral to another account
+type TransferFundsRequest struct {
+	ToAccount string `json:"to_account"`
+	Amount    string `json:"amount"`
+}
+
+func (r TransferFundsRequest) String() string {
+	data, _ := json.Marshal(r)
+	return string(data)
+}
+
+// TransferFundsOptions contains optional parameters for transferring collateral
+type TransferFundsOptions struct {
+	AgentWallet  *string
+	ExpiryWindow int64
+}
+
+// BuildTransferFundsRequest builds a signed request for transferring collateral between
+// a main account and its subaccounts
+func (s *Exchange) BuildTransferFundsRequest(params TransferFundsRequest, opts *TransferFundsOptions) (map[string]interface{}, error) {
+	return buildTransferFundsRequest(context.Background(), s, params, opts)
+}
+
+// buildTransferFundsRequest is BuildTransferFundsRequest passing ctx to the signer
+func buildTransferFundsRequest(ctx context.Context, s *Exchange, params TransferFundsRequest, opts *TransferFundsOptions) (map[string]interface{}, error) {
+	// Validate required fields
+	if params.ToAccount == "" {
+		return nil, fmt.Errorf("to_account is required")
+	}
+	if params.ToAccount == s.accountID {
+		return nil, fmt.Errorf("to_account must differ from the source account")
+	}
+	if params.Amount == "" {
+		return nil, fmt.Errorf("amount is required")
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal params: %w", err)
+	}
+
+	var operationData map[string]interface{}
+	if err := json.Unmarshal(data, &operationData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal params: %w", err)
+	}
+
+	// Determine expiry window
+	expiryWindow := int64(0)
+	if opts != nil && opts.ExpiryWindow != 0 {
+		expiryWindow = opts.ExpiryWindow
+	}
+
+	// Build signed request with operation type "transfer_funds"
+	request, err := s.BuildSignedRequestWithContext(ctx, "transfer_funds", operationData, expiryWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build signed request: %w", err)
+	}
+
+	// Add agent_wallet if provided
+	if opts != nil && opts.AgentWallet != nil {
+		request["agent_wallet"] = *opts.AgentWallet
+	}
+
+	return request, nil
+}
+
+// TransferFundsResponse represents the response from the transfer funds endpoint
+type TransferFundsResponse struct {
+	Success bool `json:"success"`
+}
+
+// TransferFunds transfers collateral from the client account to another account on Pacifica
+func (c *RESTClient) TransferFunds(params TransferFundsRequest, opts *TransferFundsOptions) (*TransferFundsResponse, error) {
+	return c.TransferFundsWithContext(context.Background(), params, opts)
+}
+
+// TransferFundsWithContext is like TransferFunds but aborts the HTTP request when ctx is done
+func (c *RESTClient) TransferFundsWithContext(ctx context.Context, params TransferFundsRequest, opts *TransferFundsOptions) (*TransferFundsResponse, error) {
+	return doSigned[TransferFundsRequest, TransferFundsResponse](ctx, c, "/account/subaccount/transfer", buildTransferFundsRequest, params, opts)
+}
@@ -0,0 +1,109 @@
+package pacifica
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCreateSubaccountRequest(t *testing.T) {
+	main := generateTestOwnerExchange(t)
+	sub := generateTestOwnerExchange(t)
+
+	req, err := main.BuildCreateSubaccountRequest(sub, &CreateSubaccountOptions{ExpiryWindow: 10000})
+	require.NoError(t, err)
+
+	assert.Equal(t, main.GetPublicKey(), req["main_account"])
+	assert.Equal(t, sub.GetPublicKey(), req["subaccount"])
+	assert.Equal(t, int64(10000), req["expiry_window"])
+
+	// Main signature authorises the subaccount key
+	mainMessage, err := createCompactJSON(map[string]interface{}{
+		"timestamp":     req["timestamp"],
+		"expiry_window": req["expiry_window"],
+		"type":          "subaccount_initiate",
+		"data":          map[string]interface{}{"account": sub.GetPublicKey()},
+	})
+	require.NoError(t, err)
+	assert.True(t, main.VerifySignature(mainMessage, req["main_signature"].(string)))
+
+	// Subaccount signature confirms the main signature with the same timestamp
+	subMessage, err := createCompactJSON(map[string]interface{}{
+		"timestamp":     req["timestamp"],
+		"expiry_window": req["expiry_window"],
+		"type":          "subaccount_confirm",
+		"data":          map[string]interface{}{"signature": req["main_signature"]},
+	})
+	require.NoError(t, err)
+	assert.True(t, sub.VerifySignature(subMessage, req["sub_signature"].(string)))
+
+	_, err = main.BuildCreateSubaccountRequest(nil, nil)
+	assert.Error(t, err)
+
+	_, err = main.BuildCreateSubaccountRequest(main, nil)
+	assert.Error(t, err)
+
+	// Agent keys cannot create subaccounts
+	_, err = generateTestExchange(t).BuildCreateSubaccountRequest(sub, nil)
+	assert.Error(t, err)
+}
+
+func TestBuildTransferFundsRequest(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	tests := []struct {
+		name     string
+		params   TransferFundsRequest
+		opts     *TransferFundsOptions
+		wantErr  bool
+		validate func(*testing.T, map[string]interface{})
+	}{
+		{
+			name: "transfer to subaccount",
+			params: TransferFundsRequest{
+				ToAccount: "42trU9A5...",
+				Amount:    "420.69",
+			},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				assert.Equal(t, "42trU9A5...", req["to_account"])
+				assert.Equal(t, "420.69", req["amount"])
+				assert.Equal(t, testAccountID, req["account"])
+				assert.Contains(t, req, "signature")
+				assert.Contains(t, req, "timestamp")
+			},
+		},
+		{
+			name:    "missing to_account",
+			params:  TransferFundsRequest{Amount: "420.69"},
+			wantErr: true,
+		},
+		{
+			name: "transfer to self",
+			params: TransferFundsRequest{
+				ToAccount: testAccountID,
+				Amount:    "420.69",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "missing amount",
+			params:  TransferFundsRequest{ToAccount: "42trU9A5..."},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := signer.BuildTransferFundsRequest(tt.params, tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.validate != nil {
+				tt.validate(t, req)
+			}
+		})
+	}
+}
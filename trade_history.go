@@ -0,0 +1,114 @@
+package pacifica
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Fill represents a single fill of an account
+type Fill struct {
+	HistoryID     int64  `json:"history_id"`
+	OrderID       int64  `json:"order_id"`
+	ClientOrderID string `json:"client_order_id"`
+	Symbol        string `json:"symbol"`
+	Amount        string `json:"amount"`
+	Price         string `json:"price"`
+	EntryPrice    string `json:"entry_price"`
+	Fee           string `json:"fee"`
+	PnL           string `json:"pnl"`
+	EventType     string `json:"event_type"`
+	Side          string `json:"side"`
+	Cause         string `json:"cause"`
+	CreatedAt     int64  `json:"created_at"`
+}
+
+// TradeHistoryParams contains the filter and pagination parameters for the trade history query.
+// Zero values are not sent.
+type TradeHistoryParams struct {
+	Symbol    string
+	StartTime time.Time
+	EndTime   time.Time
+	Cursor    string
+	Limit     int
+}
+
+// TradeHistoryPage represents a single page of the trade history
+type TradeHistoryPage struct {
+	Fills      []Fill
+	NextCursor string
+	HasMore    bool
+}
+
+type tradeHistoryResponse struct {
+	Success    bool        `json:"success"`
+	Data       []Fill      `json:"data"`
+	NextCursor string      `json:"next_cursor"`
+	HasMore    bool        `json:"has_more"`
+	Error      interface{} `json:"error"`
+	Code       interface{} `json:"code"`
+}
+
+func (c *RESTClient) GetTradeHistory(ctx context.Context, account string, params TradeHistoryParams) (*TradeHistoryPage, error) {
+	if account == "" {
+		return nil, fmt.Errorf("trade history: account is required")
+	}
+
+	query := url.Values{}
+	query.Set("account", account)
+	if params.Symbol != "" {
+		query.Set("symbol", params.Symbol)
+	}
+	if !params.StartTime.IsZero() {
+		query.Set("start_time", strconv.FormatInt(params.StartTime.UnixMilli(), 10))
+	}
+	if !params.EndTime.IsZero() {
+		query.Set("end_time", strconv.FormatInt(params.EndTime.UnixMilli(), 10))
+	}
+	if params.Cursor != "" {
+		query.Set("cursor", params.Cursor)
+	}
+	if params.Limit > 0 {
+		query.Set("limit", strconv.Itoa(params.Limit))
+	}
+
+	tradeHistoryResp, err := doGet[tradeHistoryResponse](ctx, c, "/positions/history", query)
+	if err != nil {
+		return nil, fmt.Errorf("trade history: %w", err)
+	}
+	return &TradeHistoryPage{
+		Fills:      tradeHistoryResp.Data,
+		NextCursor: tradeHistoryResp.NextCursor,
+		HasMore:    tradeHistoryResp.HasMore,
+	}, nil
+}
+
+// IterTradeHistory walks every page of the trade history starting at params.Cursor.
+// Iteration stops after the first error is yielded, including a page returning its own cursor as the next one.
+func (c *RESTClient) IterTradeHistory(ctx context.Context, account string, params TradeHistoryParams) iter.Seq2[Fill, error] {
+	return func(yield func(Fill, error) bool) {
+		for {
+			page, err := c.GetTradeHistory(ctx, account, params)
+			if err != nil {
+				yield(Fill{}, err)
+				return
+			}
+			for _, fill := range page.Fills {
+				if !yield(fill, nil) {
+					return
+				}
+			}
+			if !page.HasMore || page.NextCursor == "" {
+				return
+			}
+			if page.NextCursor == params.Cursor {
+				yield(Fill{}, fmt.Errorf("trade history: next cursor %q does not advance", page.NextCursor))
+				return
+			}
+			params.Cursor = page.NextCursor
+		}
+	}
+}
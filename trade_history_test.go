@@ -0,0 +1,106 @@
+package pacifica
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRESTClient_GetTradeHistory(t *testing.T) {
+	start := time.UnixMilli(1759215599188)
+	end := time.UnixMilli(1759225599188)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/positions/history", r.URL.Path)
+		query := r.URL.Query()
+		assert.Equal(t, testAccountID, query.Get("account"))
+		assert.Equal(t, "BTC", query.Get("symbol"))
+		assert.Equal(t, "1759215599188", query.Get("start_time"))
+		assert.Equal(t, "1759225599188", query.Get("end_time"))
+		assert.Empty(t, query.Get("cursor"))
+		_, _ = w.Write([]byte(`{"success":true,"data":[{"history_id":19329801,"order_id":315293920,"symbol":"BTC",` +
+			`"amount":"0.1","price":"50010","entry_price":"50000","fee":"0.5","pnl":"1","event_type":"fulfill_taker",` +
+			`"side":"close_long","cause":"normal","created_at":1759215599188}],"next_cursor":"","has_more":false,"error":null,"code":null}`))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, nil)
+
+	page, err := client.GetTradeHistory(context.Background(), testAccountID, TradeHistoryParams{
+		Symbol:    "BTC",
+		StartTime: start,
+		EndTime:   end,
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Fills, 1)
+	assert.False(t, page.HasMore)
+	assert.Equal(t, int64(19329801), page.Fills[0].HistoryID)
+	assert.Equal(t, "close_long", page.Fills[0].Side)
+	assert.Equal(t, "fulfill_taker", page.Fills[0].EventType)
+}
+
+func TestRESTClient_IterTradeHistory(t *testing.T) {
+	pages := map[string]string{
+		"":   `{"success":true,"data":[{"history_id":1},{"history_id":2}],"next_cursor":"c1","has_more":true}`,
+		"c1": `{"success":true,"data":[{"history_id":3}],"next_cursor":"c2","has_more":true}`,
+		"c2": `{"success":true,"data":[{"history_id":4}],"next_cursor":"","has_more":false}`,
+		"c3": `{"success":true,"data":[{"history_id":5}],"next_cursor":"c3","has_more":true}`,
+	}
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page, ok := pages[r.URL.Query().Get("cursor")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(page))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, nil)
+
+	var ids []int64
+	for fill, err := range client.IterTradeHistory(context.Background(), testAccountID, TradeHistoryParams{}) {
+		require.NoError(t, err)
+		ids = append(ids, fill.HistoryID)
+	}
+	assert.Equal(t, []int64{1, 2, 3, 4}, ids)
+	assert.Equal(t, 3, requests)
+
+	// Stopping early must not fetch further pages
+	requests = 0
+	for range client.IterTradeHistory(context.Background(), testAccountID, TradeHistoryParams{}) {
+		break
+	}
+	assert.Equal(t, 1, requests)
+
+	// Errors are yielded and end the iteration
+	var errs int
+	for _, err := range client.IterTradeHistory(context.Background(), testAccountID, TradeHistoryParams{Cursor: "unknown"}) {
+		assert.Error(t, err)
+		errs++
+	}
+	assert.Equal(t, 1, errs)
+
+	// A cursor that does not advance ends the iteration with an error instead of refetching the page
+	requests = 0
+	ids = nil
+	errs = 0
+	for fill, err := range client.IterTradeHistory(context.Background(), testAccountID, TradeHistoryParams{Cursor: "c3"}) {
+		if err != nil {
+			errs++
+			continue
+		}
+		ids = append(ids, fill.HistoryID)
+	}
+	assert.Equal(t, []int64{5}, ids)
+	assert.Equal(t, 1, errs)
+	assert.Equal(t, 1, requests)
+}
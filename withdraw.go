@@ -0,0 +1,156 @@
+package pacifica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// WithdrawRequest represents the request data for withdrawing collateral.
+// An empty Destination withdraws to the account wallet.
+type WithdrawRequest struct {
+	Amount      string `json:"amount"`
+	Destination string `json:"destination,omitempty"`
+}
+
+func (r WithdrawRequest) String() string {
+	data, _ := json.Marshal(r)
+	return string(data)
+}
+
+// WithdrawOptions contains optional parameters for withdrawing collateral
+type WithdrawOptions struct {
+	AgentWallet  *string
+	ExpiryWindow int64
+}
+
+// BuildWithdrawRequest builds a signed request for withdrawing collateral
+func (s *Exchange) BuildWithdrawRequest(params WithdrawRequest, opts *WithdrawOptions) (map[string]interface{}, error) {
+	return buildWithdrawRequest(context.Background(), s, params, opts)
+}
+
+// buildWithdrawRequest is BuildWithdrawRequest passing ctx to the signer
+func buildWithdrawRequest(ctx context.Context, s *Exchange, params WithdrawRequest, opts *WithdrawOptions) (map[string]interface{}, error) {
+	// Validate required fields
+	if params.Amount == "" {
+		return nil, fmt.Errorf("amount is required")
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal params: %w", err)
+	}
+
+	var operationData map[string]interface{}
+	if err := json.Unmarshal(data, &operationData); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal params: %w", err)
+	}
+
+	// Determine expiry window
+	expiryWindow := int64(0)
+	if opts != nil && opts.ExpiryWindow != 0 {
+		expiryWindow = opts.ExpiryWindow
+	}
+
+	// Build signed request with operation type "withdraw"
+	request, err := s.BuildSignedRequestWithContext(ctx, "withdraw", operationData, expiryWindow)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build signed request: %w", err)
+	}
+
+	// Add agent_wallet if provided
+	if opts != nil && opts.AgentWallet != nil {
+		request["agent_wallet"] = *opts.AgentWallet
+	}
+
+	return request, nil
+}
+
+// WithdrawResponse represents the response from the withdraw endpoint
+type WithdrawResponse struct {
+	Success bool `json:"success"`
+}
+
+// Withdraw requests a withdrawal of collateral from Pacifica
+func (c *RESTClient) Withdraw(params WithdrawRequest, opts *WithdrawOptions) (*WithdrawResponse, error) {
+	return c.WithdrawWithContext(context.Background(), params, opts)
+}
+
+// WithdrawWithContext is like Withdraw but aborts the HTTP request when ctx is done
+func (c *RESTClient) WithdrawWithContext(ctx context.Context, params WithdrawRequest, opts *WithdrawOptions) (*WithdrawResponse, error) {
+	return doSigned[WithdrawRequest, WithdrawResponse](ctx, c, "/account/withdraw", buildWithdrawRequest, params, opts)
+}
+
+// WithdrawalStatus represents the status of a withdrawal
+type WithdrawalStatus string
+
+const (
+	WithdrawalStatusPending   WithdrawalStatus = "pending"
+	WithdrawalStatusCompleted WithdrawalStatus = "completed"
+	WithdrawalStatusFailed    WithdrawalStatus = "failed"
+)
+
+// Withdrawal represents a withdrawal of an account
+type Withdrawal struct {
+	WithdrawalID int64            `json:"withdrawal_id"`
+	Amount       string           `json:"amount"`
+	Destination  string           `json:"destination"`
+	Status       WithdrawalStatus `json:"status"`
+	TxSignature  string           `json:"tx_signature"`
+	CreatedAt    int64            `json:"created_at"`
+	UpdatedAt    int64            `json:"updated_at"`
+}
+
+// WithdrawalsParams contains the filter and pagination parameters for the withdrawals query.
+// Zero values are not sent.
+type WithdrawalsParams struct {
+	Status WithdrawalStatus
+	Cursor string
+	Limit  int
+}
+
+// WithdrawalsPage represents a single page of the withdrawals of an account
+type WithdrawalsPage struct {
+	Withdrawals []Withdrawal
+	NextCursor  string
+	HasMore     bool
+}
+
+type withdrawalsResponse struct {
+	Success    bool         `json:"success"`
+	Data       []Withdrawal `json:"data"`
+	NextCursor string       `json:"next_cursor"`
+	HasMore    bool         `json:"has_more"`
+	Error      interface{}  `json:"error"`
+	Code       interface{}  `json:"code"`
+}
+
+func (c *RESTClient) GetWithdrawals(ctx context.Context, account string, params WithdrawalsParams) (*WithdrawalsPage, error) {
+	if account == "" {
+		return nil, fmt.Errorf("withdrawals: account is required")
+	}
+
+	query := url.Values{}
+	query.Set("account", account)
+	if params.Status != "" {
+		query.Set("status", string(params.Status))
+	}
+	if params.Cursor != "" {
+		query.Set("cursor", params.Cursor)
+	}
+	if params.Limit > 0 {
+		query.Set("limit", strconv.Itoa(params.Limit))
+	}
+
+	withdrawalsResp, err := doGet[withdrawalsResponse](ctx, c, "/account/withdrawals", query)
+	if err != nil {
+		return nil, fmt.Errorf("withdrawals: %w", err)
+	}
+	return &WithdrawalsPage{
+		Withdrawals: withdrawalsResp.Data,
+		NextCursor:  withdrawalsResp.NextCursor,
+		HasMore:     withdrawalsResp.HasMore,
+	}, nil
+}
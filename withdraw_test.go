@@ -0,0 +1,92 @@
+package pacifica
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildWithdrawRequest(t *testing.T) {
+	signer := generateTestExchange(t)
+
+	tests := []struct {
+		name     string
+		params   WithdrawRequest
+		opts     *WithdrawOptions
+		wantErr  bool
+		validate func(*testing.T, map[string]interface{})
+	}{
+		{
+			name:   "withdraw to account wallet",
+			params: WithdrawRequest{Amount: "100.5"},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				assert.Equal(t, "100.5", req["amount"])
+				assert.NotContains(t, req, "destination")
+				assert.Contains(t, req, "account")
+				assert.Contains(t, req, "signature")
+				assert.Contains(t, req, "timestamp")
+			},
+		},
+		{
+			name: "withdraw to destination",
+			params: WithdrawRequest{
+				Amount:      "100.5",
+				Destination: "42trU9A5...",
+			},
+			opts: &WithdrawOptions{ExpiryWindow: 10000},
+			validate: func(t *testing.T, req map[string]interface{}) {
+				assert.Equal(t, "42trU9A5...", req["destination"])
+				assert.Equal(t, int64(10000), req["expiry_window"])
+			},
+		},
+		{
+			name:    "missing amount",
+			params:  WithdrawRequest{Destination: "42trU9A5..."},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := signer.BuildWithdrawRequest(tt.params, tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tt.validate != nil {
+				tt.validate(t, req)
+			}
+		})
+	}
+}
+
+func TestRESTClient_GetWithdrawals(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/account/withdrawals", r.URL.Path)
+		assert.Equal(t, testAccountID, r.URL.Query().Get("account"))
+		assert.Equal(t, "pending", r.URL.Query().Get("status"))
+		_, _ = w.Write([]byte(`{"success":true,"data":[{"withdrawal_id":42,"amount":"100.5",` +
+			`"destination":"42trU9A5...","status":"pending","tx_signature":null,"created_at":1759222804122,` +
+			`"updated_at":1759222804122}],"next_cursor":"","has_more":false,"error":null,"code":null}`))
+	}))
+	defer server.Close()
+
+	client := NewRESTClient(server.URL, nil)
+
+	page, err := client.GetWithdrawals(context.Background(), testAccountID, WithdrawalsParams{
+		Status: WithdrawalStatusPending,
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Withdrawals, 1)
+	assert.Equal(t, int64(42), page.Withdrawals[0].WithdrawalID)
+	assert.Equal(t, WithdrawalStatusPending, page.Withdrawals[0].Status)
+	assert.Empty(t, page.Withdrawals[0].TxSignature)
+
+	_, err = client.GetWithdrawals(context.Background(), "", WithdrawalsParams{})
+	assert.Error(t, err)
+}